@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -44,6 +45,7 @@ var _ AuditLogsService = &auditlogsService{}
 // AuditLogs API endpoints.
 type AuditLogsService interface {
 	List(context.Context, *ListAuditLogsRequest, ...ListOption) (*CursorPaginatedResponse[*AuditLog], error)
+	All(context.Context, *ListAuditLogsRequest, ...ListOption) iter.Seq2[*AuditLog, error]
 }
 
 // ListAuditLogsRequest encapsulates the request for listing the audit logs of
@@ -125,7 +127,7 @@ func (o *auditlogsService) List(ctx context.Context, listReq *ListAuditLogsReque
 		}
 	}
 
-	req, err := o.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := o.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("AuditLogs", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for listing audit logs: %w", err)
 	}
@@ -138,6 +140,14 @@ func (o *auditlogsService) List(ctx context.Context, listReq *ListAuditLogsReque
 	return resp, nil
 }
 
+// All returns an iterator over all audit logs of an organization, fetching
+// pages as needed.
+func (o *auditlogsService) All(ctx context.Context, listReq *ListAuditLogsRequest, opts ...ListOption) iter.Seq2[*AuditLog, error] {
+	return paginateCursor(ctx, opts, func(ctx context.Context, opts ...ListOption) (*CursorPaginatedResponse[*AuditLog], error) {
+		return o.List(ctx, listReq, opts...)
+	})
+}
+
 func auditlogsAPIPath(org string) string {
 	return path.Join(organizationsAPIPath, org, "audit-log")
 }
@@ -100,3 +100,39 @@ func TestAuditLogs_List(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(auditLogs, qt.DeepEquals, want)
 }
+
+func TestAuditLogs_All(t *testing.T) {
+	c := qt.New(t)
+
+	var cursors []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("starting_after")
+		cursors = append(cursors, cursor)
+
+		w.WriteHeader(200)
+		var out string
+		switch cursor {
+		case "":
+			out = `{"has_next": true, "cursor_end": "log-2", "data": [{"id": "log-1"}, {"id": "log-2"}]}`
+		case "log-2":
+			out = `{"has_next": false, "cursor_end": "log-3", "data": [{"id": "log-3"}]}`
+		default:
+			t.Fatalf("unexpected cursor %q", cursor)
+		}
+		_, err := w.Write([]byte(out))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var ids []string
+	for log, err := range client.AuditLogs.All(context.Background(), &ListAuditLogsRequest{Organization: testOrg}) {
+		c.Assert(err, qt.IsNil)
+		ids = append(ids, log.ID)
+	}
+
+	c.Assert(ids, qt.DeepEquals, []string{"log-1", "log-2", "log-3"})
+	c.Assert(cursors, qt.DeepEquals, []string{"", "log-2"})
+}
@@ -0,0 +1,162 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/oauth2"
+)
+
+// DefaultAuthURL is the base URL of the PlanetScale OAuth server.
+const DefaultAuthURL = "https://auth.planetscale.com/"
+
+// WithTokenSource configures a client to authenticate with access tokens
+// obtained from ts. Tokens are cached until they expire, so a refreshing
+// source, such as the one returned by DeviceFlow.TokenSource, keeps a
+// long-running client authenticated.
+func WithTokenSource(ts oauth2.TokenSource) ClientOption {
+	return func(c *Client) error {
+		if ts == nil {
+			return errors.New("missing token source")
+		}
+
+		// make sure we use our own HTTP client
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, c.client)
+		c.client = oauth2.NewClient(ctx, oauth2.ReuseTokenSource(nil, ts))
+		return nil
+	}
+}
+
+// DeviceFlow authenticates a user with the OAuth 2.0 device authorization
+// grant, the way `pscale auth login` does: Start returns a code the user
+// enters in their browser, and Wait returns the token once they approve.
+type DeviceFlow struct {
+	// ClientID is the ID of the OAuth application.
+	ClientID string
+
+	// ClientSecret is the secret of the OAuth application, if it has one.
+	ClientSecret string
+
+	// AuthURL is the base URL of the OAuth server. Defaults to
+	// DefaultAuthURL.
+	AuthURL string
+
+	// Scopes are the scopes requested for the token.
+	Scopes []string
+
+	// HTTPClient is used for requests to the OAuth server. Defaults to a
+	// clean HTTP client.
+	HTTPClient *http.Client
+}
+
+func (f *DeviceFlow) config() (*oauth2.Config, error) {
+	if f.ClientID == "" {
+		return nil, errors.New("missing OAuth client ID")
+	}
+
+	authURL := f.AuthURL
+	if authURL == "" {
+		authURL = DefaultAuthURL
+	}
+	base, err := url.Parse(authURL)
+	if err != nil {
+		return nil, err
+	}
+	deviceAuthURL, err := base.Parse("oauth/authorize_device")
+	if err != nil {
+		return nil, err
+	}
+	tokenURL, err := base.Parse("oauth/token")
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     f.ClientID,
+		ClientSecret: f.ClientSecret,
+		Scopes:       f.Scopes,
+		Endpoint: oauth2.Endpoint{
+			DeviceAuthURL: deviceAuthURL.String(),
+			TokenURL:      tokenURL.String(),
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+	}, nil
+}
+
+func (f *DeviceFlow) context(ctx context.Context) context.Context {
+	httpClient := f.HTTPClient
+	if httpClient == nil {
+		httpClient = cleanhttp.DefaultClient()
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+}
+
+// Start begins the device flow. The user has to visit the returned
+// VerificationURI and enter the UserCode before the authorization expires.
+func (f *DeviceFlow) Start(ctx context.Context) (*oauth2.DeviceAuthResponse, error) {
+	cfg, err := f.config()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.DeviceAuth(f.context(ctx))
+}
+
+// Wait polls the OAuth server at the interval it requested until the user
+// approves or denies the authorization, it expires, or ctx is done.
+func (f *DeviceFlow) Wait(ctx context.Context, auth *oauth2.DeviceAuthResponse) (*oauth2.Token, error) {
+	cfg, err := f.config()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.DeviceAccessToken(f.context(ctx), auth)
+}
+
+// TokenSource returns a token source that starts with tok and uses its
+// refresh token to obtain a new one when it expires. Use
+// NewPersistingTokenSource to store refreshed tokens.
+func (f *DeviceFlow) TokenSource(ctx context.Context, tok *oauth2.Token) (oauth2.TokenSource, error) {
+	cfg, err := f.config()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.TokenSource(f.context(ctx), tok), nil
+}
+
+// NewPersistingTokenSource wraps ts and calls save every time it returns a
+// token different from the previous one, e.g. to write a refreshed token
+// back to disk. oauth2.Token values can be stored as JSON. An error from
+// save is returned by Token.
+func NewPersistingTokenSource(ts oauth2.TokenSource, save func(*oauth2.Token) error) oauth2.TokenSource {
+	return &persistingTokenSource{ts: ts, save: save}
+}
+
+type persistingTokenSource struct {
+	ts   oauth2.TokenSource
+	save func(*oauth2.Token) error
+
+	mu   sync.Mutex
+	last *oauth2.Token
+}
+
+func (p *persistingTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := p.ts.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.last != nil && p.last.AccessToken == tok.AccessToken && p.last.RefreshToken == tok.RefreshToken {
+		return tok, nil
+	}
+	if err := p.save(tok); err != nil {
+		return nil, err
+	}
+	p.last = tok
+	return tok, nil
+}
@@ -74,7 +74,7 @@ type authAttemptExportsService struct {
 var _ AuthAttemptExportsService = &authAttemptExportsService{}
 
 func (s *authAttemptExportsService) CreateExport(ctx context.Context, createReq *CreateAuthAttemptExportRequest) (*AuthAttemptExport, error) {
-	req, err := s.client.newRequest(http.MethodPost, authAttemptExportsAPIPath(createReq.Organization), createReq)
+	req, err := s.client.newRequest(http.MethodPost, authAttemptExportsAPIPath(createReq.Organization), createReq, withOperation("AuthAttemptExports", "CreateExport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -89,7 +89,7 @@ func (s *authAttemptExportsService) CreateExport(ctx context.Context, createReq
 }
 
 func (s *authAttemptExportsService) GetExport(ctx context.Context, getReq *GetAuthAttemptExportRequest) (*AuthAttemptExport, error) {
-	req, err := s.client.newRequest(http.MethodGet, authAttemptExportAPIPath(getReq.Organization, getReq.Export), nil)
+	req, err := s.client.newRequest(http.MethodGet, authAttemptExportAPIPath(getReq.Organization, getReq.Export), nil, withOperation("AuthAttemptExports", "GetExport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -108,7 +108,7 @@ func (s *authAttemptExportsService) GetExport(ctx context.Context, getReq *GetAu
 
 func (s *authAttemptExportsService) DownloadExport(ctx context.Context, downloadReq *DownloadAuthAttemptExportRequest) (io.ReadCloser, error) {
 	reqPath := path.Join(authAttemptExportAPIPath(downloadReq.Organization, downloadReq.Export), "download")
-	req, err := s.client.newRequest(http.MethodGet, reqPath, nil)
+	req, err := s.client.newRequest(http.MethodGet, reqPath, nil, withOperation("AuthAttemptExports", "DownloadExport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -0,0 +1,145 @@
+package planetscale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"golang.org/x/oauth2"
+)
+
+type countingTokenSource struct {
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{
+		AccessToken: fmt.Sprintf("token-%d", s.calls),
+		Expiry:      time.Now().Add(-time.Second),
+	}, nil
+}
+
+func TestWithTokenSource(t *testing.T) {
+	c := qt.New(t)
+
+	var auths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	source := &countingTokenSource{}
+	client, err := NewClient(WithBaseURL(ts.URL), WithTokenSource(source))
+	c.Assert(err, qt.IsNil)
+
+	for i := 0; i < 2; i++ {
+		err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/v1/organizations"), nil)
+		c.Assert(err, qt.IsNil)
+	}
+
+	// Expired tokens are fetched again from the source for every request.
+	c.Assert(auths, qt.DeepEquals, []string{"Bearer token-1", "Bearer token-2"})
+}
+
+func TestWithTokenSource_RejectsNil(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := NewClient(WithTokenSource(nil))
+	c.Assert(err, qt.ErrorMatches, "missing token source")
+}
+
+func TestDeviceFlow(t *testing.T) {
+	c := qt.New(t)
+
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/authorize_device", func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.ParseForm(), qt.IsNil)
+		c.Assert(r.Form.Get("client_id"), qt.Equals, "client-id")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"device_code": "device-code", "user_code": "ABCD-EFGH", "verification_uri": "https://example.com/device", "expires_in": 300, "interval": 1}`))
+	})
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.ParseForm(), qt.IsNil)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Form.Get("grant_type") {
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			c.Assert(r.Form.Get("device_code"), qt.Equals, "device-code")
+			polls++
+			if polls == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error": "authorization_pending"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "access-1", "refresh_token": "refresh-1", "token_type": "Bearer", "expires_in": 1}`))
+		case "refresh_token":
+			c.Assert(r.Form.Get("refresh_token"), qt.Equals, "refresh-1")
+			_, _ = w.Write([]byte(`{"access_token": "access-2", "refresh_token": "refresh-2", "token_type": "Bearer", "expires_in": 3600}`))
+		default:
+			t.Fatalf("unexpected grant type %q", r.Form.Get("grant_type"))
+		}
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	flow := &DeviceFlow{ClientID: "client-id", AuthURL: ts.URL}
+	ctx := context.Background()
+
+	auth, err := flow.Start(ctx)
+	c.Assert(err, qt.IsNil)
+	c.Assert(auth.UserCode, qt.Equals, "ABCD-EFGH")
+	c.Assert(auth.VerificationURI, qt.Equals, "https://example.com/device")
+
+	tok, err := flow.Wait(ctx, auth)
+	c.Assert(err, qt.IsNil)
+	c.Assert(tok.AccessToken, qt.Equals, "access-1")
+	c.Assert(polls, qt.Equals, 2)
+
+	// The token expires within the oauth2 package's expiry margin, so the
+	// source refreshes it right away.
+	source, err := flow.TokenSource(ctx, tok)
+	c.Assert(err, qt.IsNil)
+
+	var saved []string
+	persisting := NewPersistingTokenSource(source, func(tok *oauth2.Token) error {
+		data, err := json.Marshal(tok)
+		c.Assert(err, qt.IsNil)
+		saved = append(saved, string(data))
+		return nil
+	})
+
+	for i := 0; i < 2; i++ {
+		refreshed, err := persisting.Token()
+		c.Assert(err, qt.IsNil)
+		c.Assert(refreshed.AccessToken, qt.Equals, "access-2")
+	}
+	c.Assert(saved, qt.HasLen, 1)
+}
+
+func TestDeviceFlow_RequiresClientID(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := (&DeviceFlow{}).Start(context.Background())
+	c.Assert(err, qt.ErrorMatches, "missing OAuth client ID")
+}
+
+func TestPersistingTokenSource_SaveError(t *testing.T) {
+	c := qt.New(t)
+
+	errSave := errors.New("disk full")
+	source := NewPersistingTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "a"}), func(*oauth2.Token) error {
+		return errSave
+	})
+
+	_, err := source.Token()
+	c.Assert(err, qt.Equals, errSave)
+}
@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -29,6 +30,7 @@ type BackupPolicy struct {
 }
 
 type backupPoliciesResponse struct {
+	Pagination
 	Policies []*BackupPolicy `json:"data"`
 }
 
@@ -58,6 +60,10 @@ type CreateBackupPolicyRequest struct {
 	ScheduleTime   string `json:"schedule_time"`
 	ScheduleDay    *int   `json:"schedule_day,omitempty"`
 	ScheduleWeek   *int   `json:"schedule_week,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // UpdateBackupPolicyRequest encapsulates updating a backup policy.
@@ -86,6 +92,7 @@ type DeleteBackupPolicyRequest struct {
 // BackupPoliciesService is an interface for the PlanetScale backup policies API.
 type BackupPoliciesService interface {
 	List(context.Context, *ListBackupPoliciesRequest, ...ListOption) ([]*BackupPolicy, error)
+	All(context.Context, *ListBackupPoliciesRequest, ...ListOption) iter.Seq2[*BackupPolicy, error]
 	Get(context.Context, *GetBackupPolicyRequest) (*BackupPolicy, error)
 	Create(context.Context, *CreateBackupPolicyRequest) (*BackupPolicy, error)
 	Update(context.Context, *UpdateBackupPolicyRequest) (*BackupPolicy, error)
@@ -110,7 +117,7 @@ func (s *backupPoliciesService) List(ctx context.Context, listReq *ListBackupPol
 		}
 	}
 
-	req, err := s.client.newRequest(http.MethodGet, backupPoliciesAPIPath(listReq.Organization, listReq.Database), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, backupPoliciesAPIPath(listReq.Organization, listReq.Database), nil, WithQueryParams(*listOpts.URLValues), withOperation("BackupPolicies", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list backup policies: %w", err)
 	}
@@ -119,12 +126,21 @@ func (s *backupPoliciesService) List(ctx context.Context, listReq *ListBackupPol
 	if err := s.client.do(ctx, req, &resp); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(resp.Pagination)
 
 	return resp.Policies, nil
 }
 
+// All returns an iterator over all backup policies of a database, fetching
+// pages as needed.
+func (s *backupPoliciesService) All(ctx context.Context, listReq *ListBackupPoliciesRequest, opts ...ListOption) iter.Seq2[*BackupPolicy, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*BackupPolicy, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 func (s *backupPoliciesService) Get(ctx context.Context, getReq *GetBackupPolicyRequest) (*BackupPolicy, error) {
-	req, err := s.client.newRequest(http.MethodGet, backupPolicyAPIPath(getReq.Organization, getReq.Database, getReq.Policy), nil)
+	req, err := s.client.newRequest(http.MethodGet, backupPolicyAPIPath(getReq.Organization, getReq.Database, getReq.Policy), nil, withOperation("BackupPolicies", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get backup policy: %w", err)
 	}
@@ -138,7 +154,7 @@ func (s *backupPoliciesService) Get(ctx context.Context, getReq *GetBackupPolicy
 }
 
 func (s *backupPoliciesService) Create(ctx context.Context, createReq *CreateBackupPolicyRequest) (*BackupPolicy, error) {
-	req, err := s.client.newRequest(http.MethodPost, backupPoliciesAPIPath(createReq.Organization, createReq.Database), createReq)
+	req, err := s.client.newRequest(http.MethodPost, backupPoliciesAPIPath(createReq.Organization, createReq.Database), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("BackupPolicies", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for create backup policy: %w", err)
 	}
@@ -152,7 +168,7 @@ func (s *backupPoliciesService) Create(ctx context.Context, createReq *CreateBac
 }
 
 func (s *backupPoliciesService) Update(ctx context.Context, updateReq *UpdateBackupPolicyRequest) (*BackupPolicy, error) {
-	req, err := s.client.newRequest(http.MethodPatch, backupPolicyAPIPath(updateReq.Organization, updateReq.Database, updateReq.Policy), updateReq)
+	req, err := s.client.newRequest(http.MethodPatch, backupPolicyAPIPath(updateReq.Organization, updateReq.Database, updateReq.Policy), updateReq, withOperation("BackupPolicies", "Update"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for update backup policy: %w", err)
 	}
@@ -166,7 +182,7 @@ func (s *backupPoliciesService) Update(ctx context.Context, updateReq *UpdateBac
 }
 
 func (s *backupPoliciesService) Delete(ctx context.Context, deleteReq *DeleteBackupPolicyRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, backupPolicyAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Policy), nil)
+	req, err := s.client.newRequest(http.MethodDelete, backupPolicyAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Policy), nil, withOperation("BackupPolicies", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating request for delete backup policy: %w", err)
 	}
@@ -3,25 +3,67 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
 )
 
 type Backup struct {
-	PublicID    string    `json:"id"`
-	Name        string    `json:"name"`
-	State       string    `json:"state"`
-	Size        int64     `json:"size"`
-	Actor       *Actor    `json:"actor"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	StartedAt   time.Time `json:"started_at"`
-	ExpiresAt   time.Time `json:"expires_at"`
-	CompletedAt time.Time `json:"completed_at"`
+	PublicID    string      `json:"id"`
+	Name        string      `json:"name"`
+	State       BackupState `json:"state"`
+	Size        int64       `json:"size"`
+	Actor       *Actor      `json:"actor"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	StartedAt   time.Time   `json:"started_at"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+	CompletedAt time.Time   `json:"completed_at"`
+}
+
+// BackupState is the state of a backup.
+type BackupState string
+
+const (
+	BackupPending  BackupState = "pending"
+	BackupRunning  BackupState = "running"
+	BackupSuccess  BackupState = "success"
+	BackupFailed   BackupState = "failed"
+	BackupCanceled BackupState = "canceled"
+)
+
+var backupStates = map[BackupState]stateClass{
+	BackupPending:  stateWaiting,
+	BackupRunning:  stateInProgress,
+	BackupSuccess:  stateFinished,
+	BackupFailed:   stateFailed,
+	BackupCanceled: stateFinished,
+}
+
+// IsKnown reports whether this package declares s.
+func (s BackupState) IsKnown() bool {
+	return backupStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the backup finished, successfully or not.
+func (s BackupState) IsTerminal() bool {
+	return backupStates[s].terminal()
+}
+
+// IsFailed reports whether the backup failed. A canceled backup isn't
+// failed.
+func (s BackupState) IsFailed() bool {
+	return backupStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the backup is being taken.
+func (s BackupState) IsInProgress() bool {
+	return backupStates[s] == stateInProgress
 }
 
 type backupsResponse struct {
+	Pagination
 	Backups []*Backup `json:"data"`
 }
 
@@ -34,6 +76,10 @@ type CreateBackupRequest struct {
 	RetentionUnit  string `json:"retention_unit,omitempty"`
 	RetentionValue int    `json:"retention_value,omitempty"`
 	Emergency      bool   `json:"emergency,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 type ListBackupsRequest struct {
@@ -49,6 +95,34 @@ type GetBackupRequest struct {
 	Backup       string
 }
 
+// WaitForBackupRequest encapsulates the request for waiting until a backup
+// completes.
+type WaitForBackupRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+	Backup       string
+
+	// RetentionUnit and RetentionValue, if set, are the retention the
+	// backup was created with. The backup's expiry is verified against them.
+	RetentionUnit  string
+	RetentionValue int
+
+	// Progress, if set, is called with the backup after every poll.
+	Progress func(*Backup)
+}
+
+// BackupVerificationError is returned by WaitForBackup when a backup
+// completes but fails verification.
+type BackupVerificationError struct {
+	Backup string
+	Reason string
+}
+
+func (e *BackupVerificationError) Error() string {
+	return fmt.Sprintf("backup %s failed verification: %s", e.Backup, e.Reason)
+}
+
 type DeleteBackupRequest struct {
 	Organization string
 	Database     string
@@ -60,8 +134,10 @@ type DeleteBackupRequest struct {
 // backup API endpoint.
 type BackupsService interface {
 	Create(context.Context, *CreateBackupRequest) (*Backup, error)
-	List(context.Context, *ListBackupsRequest) ([]*Backup, error)
+	List(context.Context, *ListBackupsRequest, ...ListOption) ([]*Backup, error)
+	All(context.Context, *ListBackupsRequest, ...ListOption) iter.Seq2[*Backup, error]
 	Get(context.Context, *GetBackupRequest) (*Backup, error)
+	WaitForBackup(context.Context, *WaitForBackupRequest, ...WaitOption) (*Backup, error)
 	Delete(context.Context, *DeleteBackupRequest) error
 }
 
@@ -80,7 +156,7 @@ func NewBackupsService(client *Client) *backupsService {
 // Creates a new backup for a branch.
 func (d *backupsService) Create(ctx context.Context, createReq *CreateBackupRequest) (*Backup, error) {
 	path := backupsAPIPath(createReq.Organization, createReq.Database, createReq.Branch)
-	req, err := d.client.newRequest(http.MethodPost, path, createReq)
+	req, err := d.client.newRequest(http.MethodPost, path, createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("Backups", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -96,7 +172,7 @@ func (d *backupsService) Create(ctx context.Context, createReq *CreateBackupRequ
 // Returns a single backup for a branch.
 func (d *backupsService) Get(ctx context.Context, getReq *GetBackupRequest) (*Backup, error) {
 	path := backupAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Backup)
-	req, err := d.client.newRequest(http.MethodGet, path, nil)
+	req, err := d.client.newRequest(http.MethodGet, path, nil, withOperation("Backups", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -109,9 +185,105 @@ func (d *backupsService) Get(ctx context.Context, getReq *GetBackupRequest) (*Ba
 	return backup, nil
 }
 
-// Returns all of the backups for a branch.
-func (d *backupsService) List(ctx context.Context, listReq *ListBackupsRequest) ([]*Backup, error) {
-	req, err := d.client.newRequest(http.MethodGet, backupsAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil)
+// backupExpiryTolerance is how far a backup's expiry may be from the one its
+// retention implies.
+const backupExpiryTolerance = time.Minute
+
+// WaitForBackup polls the backup until it completes, verifies it and returns
+// it. A completed backup must have a size and, if the request has a
+// retention, expire accordingly; otherwise WaitForBackup fails with a
+// *BackupVerificationError. A backup that fails or is canceled is reported
+// with a *StateError. If ctx is done first, WaitForBackup returns ctx's error
+// along with the last backup seen.
+func (d *backupsService) WaitForBackup(ctx context.Context, waitReq *WaitForBackupRequest, opts ...WaitOption) (*Backup, error) {
+	checkRetention := waitReq.RetentionUnit != "" && waitReq.RetentionValue > 0
+	if checkRetention {
+		if _, ok := addRetention(time.Time{}, waitReq.RetentionUnit, waitReq.RetentionValue); !ok {
+			return nil, fmt.Errorf("unknown retention unit %q", waitReq.RetentionUnit)
+		}
+	}
+
+	getReq := &GetBackupRequest{
+		Organization: waitReq.Organization,
+		Database:     waitReq.Database,
+		Branch:       waitReq.Branch,
+		Backup:       waitReq.Backup,
+	}
+
+	var backup *Backup
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		b, err := d.Get(ctx, getReq)
+		if err != nil {
+			return false, err
+		}
+		backup = b
+		if waitReq.Progress != nil {
+			waitReq.Progress(b)
+		}
+		if b.State.IsTerminal() && b.State != BackupSuccess {
+			return false, &StateError{Resource: "backup " + waitReq.Backup, State: string(b.State)}
+		}
+		return b.State == BackupSuccess, nil
+	})
+	if err != nil {
+		return backup, err
+	}
+
+	if backup.Size <= 0 {
+		return backup, &BackupVerificationError{Backup: waitReq.Backup, Reason: "backup is empty"}
+	}
+	if checkRetention {
+		// The expiry may be computed from when the backup was created or
+		// from when it completed. Without a completion time, only the
+		// former bound can be checked.
+		earliest, _ := addRetention(backup.CreatedAt, waitReq.RetentionUnit, waitReq.RetentionValue)
+		tooEarly := backup.ExpiresAt.Before(earliest.Add(-backupExpiryTolerance))
+		tooLate := false
+		if !backup.CompletedAt.IsZero() {
+			latest, _ := addRetention(backup.CompletedAt, waitReq.RetentionUnit, waitReq.RetentionValue)
+			if latest.Before(earliest) {
+				latest = earliest
+			}
+			tooLate = backup.ExpiresAt.After(latest.Add(backupExpiryTolerance))
+		}
+		if tooEarly || tooLate {
+			return backup, &BackupVerificationError{
+				Backup: waitReq.Backup,
+				Reason: fmt.Sprintf("backup expires at %s, not %d %s after it was taken", backup.ExpiresAt.Format(time.RFC3339), waitReq.RetentionValue, waitReq.RetentionUnit),
+			}
+		}
+	}
+	return backup, nil
+}
+
+// addRetention returns t plus value retention units: hours, days, weeks,
+// months or years.
+func addRetention(t time.Time, unit string, value int) (time.Time, bool) {
+	switch unit {
+	case "hour":
+		return t.Add(time.Duration(value) * time.Hour), true
+	case "day":
+		return t.AddDate(0, 0, value), true
+	case "week":
+		return t.AddDate(0, 0, 7*value), true
+	case "month":
+		return t.AddDate(0, value, 0), true
+	case "year":
+		return t.AddDate(value, 0, 0), true
+	}
+	return time.Time{}, false
+}
+
+// Returns the backups for a branch, one page at a time.
+func (d *backupsService) List(ctx context.Context, listReq *ListBackupsRequest, opts ...ListOption) ([]*Backup, error) {
+	listOpts := defaultListOptions()
+	for _, opt := range opts {
+		if err := opt(listOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := d.client.newRequest(http.MethodGet, backupsAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("Backups", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -120,14 +292,23 @@ func (d *backupsService) List(ctx context.Context, listReq *ListBackupsRequest)
 	if err := d.client.do(ctx, req, &backups); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(backups.Pagination)
 
 	return backups.Backups, nil
 }
 
+// All returns an iterator over all backups of a branch, fetching pages as
+// needed.
+func (d *backupsService) All(ctx context.Context, listReq *ListBackupsRequest, opts ...ListOption) iter.Seq2[*Backup, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*Backup, error) {
+		return d.List(ctx, listReq, opts...)
+	})
+}
+
 // Deletes a branch backup.
 func (d *backupsService) Delete(ctx context.Context, deleteReq *DeleteBackupRequest) error {
 	path := backupAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.Backup)
-	req, err := d.client.newRequest(http.MethodDelete, path, nil)
+	req, err := d.client.newRequest(http.MethodDelete, path, nil, withOperation("Backups", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -110,6 +110,46 @@ func TestBackups_ListEmpty(t *testing.T) {
 	c.Assert(backups, qt.HasLen, 0)
 }
 
+func TestBackups_All(t *testing.T) {
+	c := qt.New(t)
+
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+
+		w.WriteHeader(200)
+		var out string
+		switch page {
+		case "1":
+			out = `{"current_page": 1, "next_page": 2, "data": [{"id": "backup-1"}]}`
+		case "2":
+			out = `{"current_page": 2, "next_page": null, "data": [{"id": "backup-2"}]}`
+		default:
+			t.Fatalf("unexpected page %q", page)
+		}
+		_, err := w.Write([]byte(out))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var ids []string
+	for backup, err := range client.Backups.All(context.Background(), &ListBackupsRequest{
+		Organization: "my-org",
+		Database:     "planetscale-go-test-db",
+		Branch:       "my-branch",
+	}) {
+		c.Assert(err, qt.IsNil)
+		ids = append(ids, backup.PublicID)
+	}
+
+	c.Assert(ids, qt.DeepEquals, []string{"backup-1", "backup-2"})
+	c.Assert(pages, qt.DeepEquals, []string{"1", "2"})
+}
+
 func TestBackups_Get(t *testing.T) {
 	c := qt.New(t)
 
@@ -145,3 +185,105 @@ func TestBackups_Get(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(backup, qt.DeepEquals, want)
 }
+
+func TestBackups_WaitForBackup(t *testing.T) {
+	const (
+		created   = `"created_at":"2021-01-14T10:00:00.000Z"`
+		completed = `"completed_at":"2021-01-14T10:05:00.000Z"`
+		pending   = `{"id":"backup-1","name":"` + testBackup + `","state":"pending",` + created + `}`
+	)
+	tests := []struct {
+		desc     string
+		final    string
+		wantErr  string
+		wantSize int64
+	}{
+		{
+			desc:     "verified",
+			final:    `{"id":"backup-1","name":"` + testBackup + `","state":"success","size":1024,` + created + `,` + completed + `,"expires_at":"2021-01-21T10:05:00.000Z"}`,
+			wantSize: 1024,
+		},
+		{
+			desc:    "empty",
+			final:   `{"id":"backup-1","name":"` + testBackup + `","state":"success","size":0,` + created + `,` + completed + `,"expires_at":"2021-01-21T10:00:00.000Z"}`,
+			wantErr: "backup backup-1 failed verification: backup is empty",
+		},
+		{
+			desc:    "wrong retention",
+			final:   `{"id":"backup-1","name":"` + testBackup + `","state":"success","size":1024,` + created + `,` + completed + `,"expires_at":"2021-01-15T10:00:00.000Z"}`,
+			wantErr: "backup backup-1 failed verification: backup expires at 2021-01-15T10:00:00Z, not 1 week after it was taken",
+		},
+		{
+			desc:     "no completion time",
+			final:    `{"id":"backup-1","name":"` + testBackup + `","state":"success","size":1024,` + created + `,"expires_at":"2021-01-21T10:05:00.000Z"}`,
+			wantSize: 1024,
+		},
+		{
+			desc:    "failed",
+			final:   `{"id":"backup-1","name":"` + testBackup + `","state":"failed",` + created + `}`,
+			wantErr: "backup backup-1 is in state failed",
+		},
+		{
+			desc:    "canceled",
+			final:   `{"id":"backup-1","name":"","state":"canceled",` + created + `}`,
+			wantErr: "backup backup-1 is in state canceled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			responses := []string{pending, tt.final}
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Assert(r.URL.Path, qt.Equals, "/v1/organizations/my-org/databases/my-db/branches/main/backups/backup-1")
+				res := responses[0]
+				responses = responses[1:]
+				w.WriteHeader(200)
+				_, _ = w.Write([]byte(res))
+			}))
+			t.Cleanup(ts.Close)
+
+			client, err := NewClient(WithBaseURL(ts.URL))
+			c.Assert(err, qt.IsNil)
+
+			var states []BackupState
+			backup, err := client.Backups.WaitForBackup(context.Background(), &WaitForBackupRequest{
+				Organization:   "my-org",
+				Database:       "my-db",
+				Branch:         "main",
+				Backup:         "backup-1",
+				RetentionUnit:  "week",
+				RetentionValue: 1,
+				Progress: func(b *Backup) {
+					states = append(states, b.State)
+				},
+			}, WithPollInterval(time.Millisecond, time.Millisecond))
+			if tt.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, tt.wantErr)
+			} else {
+				c.Assert(err, qt.IsNil)
+				c.Assert(backup.Size, qt.Equals, tt.wantSize)
+			}
+			c.Assert(states, qt.HasLen, 2)
+			c.Assert(states[0], qt.Equals, BackupPending)
+		})
+	}
+}
+
+func TestBackups_WaitForBackupUnknownRetention(t *testing.T) {
+	c := qt.New(t)
+
+	client, err := NewClient(WithBaseURL("http://127.0.0.1:0"))
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Backups.WaitForBackup(context.Background(), &WaitForBackupRequest{
+		Organization:   "my-org",
+		Database:       "my-db",
+		Branch:         "main",
+		Backup:         "backup-1",
+		RetentionUnit:  "fortnight",
+		RetentionValue: 1,
+	})
+	c.Assert(err, qt.ErrorMatches, `unknown retention unit "fortnight"`)
+}
@@ -143,7 +143,7 @@ func (s *branchInfrastructureService) Get(ctx context.Context, getReq *GetBranch
 		"infrastructure",
 	)
 
-	req, err := s.client.newRequest(http.MethodGet, p, nil)
+	req, err := s.client.newRequest(http.MethodGet, p, nil, withOperation("BranchInfrastructure", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get branch infrastructure: %w", err)
 	}
@@ -10,10 +10,10 @@ import (
 
 // BranchResizeRequest represents a Vitess branch VTGate resize request.
 type BranchResizeRequest struct {
-	ID    string `json:"id"`
-	Type  string `json:"type"`
-	State string `json:"state"`
-	Actor *Actor `json:"actor"`
+	ID    string      `json:"id"`
+	Type  string      `json:"type"`
+	State ResizeState `json:"state"`
+	Actor *Actor      `json:"actor"`
 
 	VTGateSize                         string `json:"vtgate_size"`
 	PreviousVTGateSize                 string `json:"previous_vtgate_size"`
@@ -36,6 +36,50 @@ type BranchResizeRequest struct {
 	CompletedAt *time.Time `json:"completed_at"`
 }
 
+// ResizeState is the state of a resize request: a BranchResizeRequest,
+// KeyspaceResizeRequest, PostgresBouncerResizeRequest or
+// PostgresBranchClusterResizeRequest.
+type ResizeState string
+
+const (
+	ResizeQueued    ResizeState = "queued"
+	ResizePending   ResizeState = "pending"
+	ResizeResizing  ResizeState = "resizing"
+	ResizeCompleted ResizeState = "completed"
+	ResizeCanceled  ResizeState = "canceled"
+	ResizeFailed    ResizeState = "failed"
+)
+
+var resizeStates = map[ResizeState]stateClass{
+	ResizeQueued:    stateWaiting,
+	ResizePending:   stateWaiting,
+	ResizeResizing:  stateInProgress,
+	ResizeCompleted: stateFinished,
+	ResizeCanceled:  stateFinished,
+	ResizeFailed:    stateFailed,
+}
+
+// IsKnown reports whether this package declares s.
+func (s ResizeState) IsKnown() bool {
+	return resizeStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the resize completed, was canceled or
+// failed.
+func (s ResizeState) IsTerminal() bool {
+	return resizeStates[s].terminal()
+}
+
+// IsFailed reports whether the resize failed.
+func (s ResizeState) IsFailed() bool {
+	return resizeStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the resize is being applied.
+func (s ResizeState) IsInProgress() bool {
+	return resizeStates[s] == stateInProgress
+}
+
 // ResizeBranchRequest encapsulates a request to resize a branch's VTGates.
 type ResizeBranchRequest struct {
 	Organization string `json:"-"`
@@ -80,7 +124,7 @@ func branchResizesAPIPath(org, db, branch string) string {
 
 // Resize queues or updates a VTGate resize for a Vitess branch.
 func (d *databaseBranchesService) Resize(ctx context.Context, resizeReq *ResizeBranchRequest) (*BranchResizeRequest, error) {
-	req, err := d.client.newRequest(http.MethodPut, branchResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch), resizeReq)
+	req, err := d.client.newRequest(http.MethodPut, branchResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch), resizeReq, withOperation("DatabaseBranches", "Resize"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -95,7 +139,7 @@ func (d *databaseBranchesService) Resize(ctx context.Context, resizeReq *ResizeB
 
 // ListResizes returns VTGate resize requests for a Vitess branch.
 func (d *databaseBranchesService) ListResizes(ctx context.Context, listReq *ListBranchResizesRequest) ([]*BranchResizeRequest, error) {
-	req, err := d.client.newRequest(http.MethodGet, branchResizesAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil)
+	req, err := d.client.newRequest(http.MethodGet, branchResizesAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil, withOperation("DatabaseBranches", "ListResizes"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -110,7 +154,7 @@ func (d *databaseBranchesService) ListResizes(ctx context.Context, listReq *List
 
 // CancelResize cancels a queued VTGate resize for a Vitess branch.
 func (d *databaseBranchesService) CancelResize(ctx context.Context, cancelReq *CancelBranchResizeRequest) error {
-	req, err := d.client.newRequest(http.MethodDelete, branchResizesAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch), nil)
+	req, err := d.client.newRequest(http.MethodDelete, branchResizesAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch), nil, withOperation("DatabaseBranches", "CancelResize"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
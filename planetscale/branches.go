@@ -2,7 +2,9 @@ package planetscale
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"path"
@@ -31,9 +33,26 @@ type DatabaseBranch struct {
 	SafeMigrations bool      `json:"safe_migrations"`
 	VTGateSize     string    `json:"vtgate_size"`
 	VTGateCount    int       `json:"vtgate_count"`
+
+	// Extra holds the response fields this package doesn't know about yet.
+	// It keeps DatabaseBranch from being used with == or as a map key; use
+	// ID for those instead.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the branch, keeping undeclared fields in Extra.
+func (d *DatabaseBranch) UnmarshalJSON(data []byte) error {
+	type databaseBranch DatabaseBranch
+	extra, err := unmarshalWithExtra(data, (*databaseBranch)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
 }
 
 type databaseBranchesResponse struct {
+	Pagination
 	Branches []*DatabaseBranch `json:"data"`
 }
 
@@ -48,6 +67,10 @@ type CreateDatabaseBranchRequest struct {
 	BackupID     string `json:"backup_id,omitempty"`
 	SeedData     string `json:"seed_data,omitempty"`
 	ClusterSize  string `json:"cluster_size,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // ListDatabaseBranchesRequest encapsulates the request for listing the branches
@@ -65,6 +88,17 @@ type GetDatabaseBranchRequest struct {
 	Branch       string
 }
 
+// WaitForBranchReadyRequest encapsulates the request for waiting until a
+// database branch is ready.
+type WaitForBranchReadyRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+
+	// Progress, if set, is called with the branch after every poll.
+	Progress func(*DatabaseBranch)
+}
+
 // DeleteDatabaseRequest encapsulates the request for deleting a database branch
 // from a database.
 type DeleteDatabaseBranchRequest struct {
@@ -169,7 +203,9 @@ type RoutingRules struct {
 type DatabaseBranchesService interface {
 	Create(context.Context, *CreateDatabaseBranchRequest) (*DatabaseBranch, error)
 	List(context.Context, *ListDatabaseBranchesRequest, ...ListOption) ([]*DatabaseBranch, error)
+	All(context.Context, *ListDatabaseBranchesRequest, ...ListOption) iter.Seq2[*DatabaseBranch, error]
 	Get(context.Context, *GetDatabaseBranchRequest) (*DatabaseBranch, error)
+	WaitForBranchReady(context.Context, *WaitForBranchReadyRequest, ...WaitOption) (*DatabaseBranch, error)
 	Delete(context.Context, *DeleteDatabaseBranchRequest) error
 	Diff(context.Context, *DiffBranchRequest) ([]*Diff, error)
 	Schema(context.Context, *BranchSchemaRequest) ([]*Diff, error)
@@ -186,6 +222,7 @@ type DatabaseBranchesService interface {
 	ListResizes(context.Context, *ListBranchResizesRequest) ([]*BranchResizeRequest, error)
 	CancelResize(context.Context, *CancelBranchResizeRequest) error
 	ResizeStatus(context.Context, *BranchResizeStatusRequest) (*BranchResizeRequest, error)
+	WaitForResize(context.Context, *WaitForBranchResizeRequest, ...WaitOption) (*BranchResizeRequest, error)
 }
 
 // ListBranchClusterSKUsRequest encapsulates the request for getting a list of Cluster SKUs for a branch.
@@ -209,7 +246,7 @@ func NewDatabaseBranchesService(client *Client) *databaseBranchesService {
 
 func (d *databaseBranchesService) Diff(ctx context.Context, diffReq *DiffBranchRequest) ([]*Diff, error) {
 	path := path.Join(databaseBranchAPIPath(diffReq.Organization, diffReq.Database, diffReq.Branch), "diff")
-	req, err := d.client.newRequest(http.MethodGet, path, nil)
+	req, err := d.client.newRequest(http.MethodGet, path, nil, withOperation("DatabaseBranches", "Diff"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -234,7 +271,7 @@ func (d *databaseBranchesService) Schema(ctx context.Context, schemaReq *BranchS
 		v.Add("keyspace", schemaReq.Keyspace)
 	}
 
-	req, err := d.client.newRequest(http.MethodGet, path, nil, WithQueryParams(v))
+	req, err := d.client.newRequest(http.MethodGet, path, nil, WithQueryParams(v), withOperation("DatabaseBranches", "Schema"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -250,7 +287,7 @@ func (d *databaseBranchesService) Schema(ctx context.Context, schemaReq *BranchS
 func (d *databaseBranchesService) RoutingRules(ctx context.Context, routingRulesReq *BranchRoutingRulesRequest) (*RoutingRules, error) {
 	path := path.Join(databaseBranchAPIPath(routingRulesReq.Organization, routingRulesReq.Database, routingRulesReq.Branch), "routing-rules")
 
-	req, err := d.client.newRequest(http.MethodGet, path, nil)
+	req, err := d.client.newRequest(http.MethodGet, path, nil, withOperation("DatabaseBranches", "RoutingRules"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -266,7 +303,7 @@ func (d *databaseBranchesService) RoutingRules(ctx context.Context, routingRules
 func (d *databaseBranchesService) UpdateRoutingRules(ctx context.Context, updateRoutingRulesReq *UpdateBranchRoutingRulesRequest) (*RoutingRules, error) {
 	path := path.Join(databaseBranchAPIPath(updateRoutingRulesReq.Organization, updateRoutingRulesReq.Database, updateRoutingRulesReq.Branch), "routing-rules")
 
-	req, err := d.client.newRequest(http.MethodPatch, path, updateRoutingRulesReq)
+	req, err := d.client.newRequest(http.MethodPatch, path, updateRoutingRulesReq, withOperation("DatabaseBranches", "UpdateRoutingRules"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -283,7 +320,7 @@ func (d *databaseBranchesService) UpdateRoutingRules(ctx context.Context, update
 func (d *databaseBranchesService) Create(ctx context.Context, createReq *CreateDatabaseBranchRequest) (*DatabaseBranch, error) {
 	path := databaseBranchesAPIPath(createReq.Organization, createReq.Database)
 
-	req, err := d.client.newRequest(http.MethodPost, path, createReq)
+	req, err := d.client.newRequest(http.MethodPost, path, createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("DatabaseBranches", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for branch database: %w", err)
 	}
@@ -299,7 +336,7 @@ func (d *databaseBranchesService) Create(ctx context.Context, createReq *CreateD
 // Get returns a database branch for an organization's database.
 func (d *databaseBranchesService) Get(ctx context.Context, getReq *GetDatabaseBranchRequest) (*DatabaseBranch, error) {
 	path := path.Join(databaseBranchesAPIPath(getReq.Organization, getReq.Database), getReq.Branch)
-	req, err := d.client.newRequest(http.MethodGet, path, nil)
+	req, err := d.client.newRequest(http.MethodGet, path, nil, withOperation("DatabaseBranches", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -312,6 +349,33 @@ func (d *databaseBranchesService) Get(ctx context.Context, getReq *GetDatabaseBr
 	return dbBranch, nil
 }
 
+// WaitForBranchReady polls the branch until it is ready, and returns it. A
+// branch that was just created may not be found yet, so such errors and
+// other transient ones are tolerated as set with WithErrorTolerance. Vitess
+// branches report no state to fail on. If ctx is done first, it returns
+// ctx's error along with the last branch seen.
+func (d *databaseBranchesService) WaitForBranchReady(ctx context.Context, waitReq *WaitForBranchReadyRequest, opts ...WaitOption) (*DatabaseBranch, error) {
+	getReq := &GetDatabaseBranchRequest{
+		Organization: waitReq.Organization,
+		Database:     waitReq.Database,
+		Branch:       waitReq.Branch,
+	}
+
+	var branch *DatabaseBranch
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		b, err := d.Get(ctx, getReq)
+		if err != nil {
+			return false, err
+		}
+		branch = b
+		if waitReq.Progress != nil {
+			waitReq.Progress(b)
+		}
+		return b.Ready, nil
+	})
+	return branch, err
+}
+
 // List returns all of the branches for an organization's
 // database.
 func (d *databaseBranchesService) List(ctx context.Context, listReq *ListDatabaseBranchesRequest, opts ...ListOption) ([]*DatabaseBranch, error) {
@@ -325,7 +389,7 @@ func (d *databaseBranchesService) List(ctx context.Context, listReq *ListDatabas
 		}
 	}
 
-	req, err := d.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := d.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("DatabaseBranches", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -334,15 +398,24 @@ func (d *databaseBranchesService) List(ctx context.Context, listReq *ListDatabas
 	if err := d.client.do(ctx, req, &dbBranches); err != nil {
 		return nil, err
 	}
+	defaultOpts.setPagination(dbBranches.Pagination)
 
 	return dbBranches.Branches, nil
 }
 
+// All returns an iterator over all branches of a database, fetching pages as
+// needed.
+func (d *databaseBranchesService) All(ctx context.Context, listReq *ListDatabaseBranchesRequest, opts ...ListOption) iter.Seq2[*DatabaseBranch, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*DatabaseBranch, error) {
+		return d.List(ctx, listReq, opts...)
+	})
+}
+
 // Delete deletes a database branch from an organization's database.
 func (d *databaseBranchesService) Delete(ctx context.Context, deleteReq *DeleteDatabaseBranchRequest) error {
 	path := path.Join(databaseBranchesAPIPath(deleteReq.Organization, deleteReq.Database), deleteReq.Branch)
 
-	var opts []RequestOption
+	opts := []RequestOption{withOperation("DatabaseBranches", "Delete")}
 	if deleteReq.DeleteDescendants {
 		v := url.Values{}
 		v.Set("delete_descendants", "true")
@@ -361,7 +434,7 @@ func (d *databaseBranchesService) Delete(ctx context.Context, deleteReq *DeleteD
 // RefreshSchema refreshes the schema for a
 func (d *databaseBranchesService) RefreshSchema(ctx context.Context, refreshReq *RefreshSchemaRequest) error {
 	path := path.Join(databaseBranchesAPIPath(refreshReq.Organization, refreshReq.Database), refreshReq.Branch, "refresh-schema")
-	req, err := d.client.newRequest(http.MethodPost, path, nil)
+	req, err := d.client.newRequest(http.MethodPost, path, nil, withOperation("DatabaseBranches", "RefreshSchema"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -376,7 +449,7 @@ func (d *databaseBranchesService) RefreshSchema(ctx context.Context, refreshReq
 // Promote promotes a branch from development to production.
 func (d *databaseBranchesService) Promote(ctx context.Context, promoteReq *PromoteRequest) (*DatabaseBranch, error) {
 	path := path.Join(databaseBranchAPIPath(promoteReq.Organization, promoteReq.Database, promoteReq.Branch), "promote")
-	req, err := d.client.newRequest(http.MethodPost, path, nil)
+	req, err := d.client.newRequest(http.MethodPost, path, nil, withOperation("DatabaseBranches", "Promote"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for branch promotion: %w", err)
 	}
@@ -394,7 +467,7 @@ func (d *databaseBranchesService) Promote(ctx context.Context, promoteReq *Promo
 // will prevent DDL statements from being performed on the branch.
 func (d *databaseBranchesService) EnableSafeMigrations(ctx context.Context, enableReq *EnableSafeMigrationsRequest) (*DatabaseBranch, error) {
 	path := path.Join(databaseBranchAPIPath(enableReq.Organization, enableReq.Database, enableReq.Branch), "safe-migrations")
-	req, err := d.client.newRequest(http.MethodPost, path, nil)
+	req, err := d.client.newRequest(http.MethodPost, path, nil, withOperation("DatabaseBranches", "EnableSafeMigrations"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for enabling safe migrations: %w", err)
 	}
@@ -412,7 +485,7 @@ func (d *databaseBranchesService) EnableSafeMigrations(ctx context.Context, enab
 // will allow DDL statements to be performed on the branch.
 func (d *databaseBranchesService) DisableSafeMigrations(ctx context.Context, disableReq *DisableSafeMigrationsRequest) (*DatabaseBranch, error) {
 	path := path.Join(databaseBranchAPIPath(disableReq.Organization, disableReq.Database, disableReq.Branch), "safe-migrations")
-	req, err := d.client.newRequest(http.MethodDelete, path, nil)
+	req, err := d.client.newRequest(http.MethodDelete, path, nil, withOperation("DatabaseBranches", "DisableSafeMigrations"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for disabling safe migrations: %w", err)
 	}
@@ -431,7 +504,7 @@ func (d *databaseBranchesService) DisableSafeMigrations(ctx context.Context, dis
 // second call by a different admin in order to complete demotion.
 func (d *databaseBranchesService) Demote(ctx context.Context, demoteReq *DemoteRequest) (*DatabaseBranch, error) {
 	path := path.Join(databaseBranchAPIPath(demoteReq.Organization, demoteReq.Database, demoteReq.Branch), "demote")
-	req, err := d.client.newRequest(http.MethodPost, path, nil)
+	req, err := d.client.newRequest(http.MethodPost, path, nil, withOperation("DatabaseBranches", "Demote"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for branch demotion: %w", err)
 	}
@@ -454,7 +527,7 @@ type lintSchemaResponse struct {
 // may be present.
 func (d *databaseBranchesService) LintSchema(ctx context.Context, lintReq *LintSchemaRequest) ([]*SchemaLintError, error) {
 	path := path.Join(databaseBranchAPIPath(lintReq.Organization, lintReq.Database, lintReq.Branch), "schema", "lint")
-	req, err := d.client.newRequest(http.MethodGet, path, nil)
+	req, err := d.client.newRequest(http.MethodGet, path, nil, withOperation("DatabaseBranches", "LintSchema"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for linting branch schema: %w", err)
 	}
@@ -479,7 +552,7 @@ func (o *databaseBranchesService) ListClusterSKUs(ctx context.Context, listReq *
 		}
 	}
 
-	req, err := o.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := o.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("DatabaseBranches", "ListClusterSKUs"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
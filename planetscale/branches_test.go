@@ -702,7 +702,7 @@ func TestDatabaseBranches_Resize(t *testing.T) {
 
 	c.Assert(err, qt.IsNil)
 	c.Assert(resize.ID, qt.Equals, "resize-id")
-	c.Assert(resize.State, qt.Equals, "pending")
+	c.Assert(resize.State, qt.Equals, ResizePending)
 	c.Assert(resize.VTGateName, qt.Equals, "VTG_320")
 	c.Assert(resize.PreviousVTGateName, qt.Equals, "VTG_5")
 	c.Assert(resize.VTGateCount, qt.Equals, 2)
@@ -734,7 +734,7 @@ func TestDatabaseBranches_ListResizes(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(resizes, qt.HasLen, 1)
 	c.Assert(resizes[0].ID, qt.Equals, "resize-id")
-	c.Assert(resizes[0].State, qt.Equals, "completed")
+	c.Assert(resizes[0].State, qt.Equals, ResizeCompleted)
 }
 
 func TestDatabaseBranches_CancelResize(t *testing.T) {
@@ -781,7 +781,7 @@ func TestDatabaseBranches_ResizeStatus(t *testing.T) {
 
 	c.Assert(err, qt.IsNil)
 	c.Assert(resize.ID, qt.Equals, "latest")
-	c.Assert(resize.State, qt.Equals, "queued")
+	c.Assert(resize.State, qt.Equals, ResizeQueued)
 	c.Assert(resize.VTGateName, qt.Equals, "VTG_640")
 }
 
@@ -812,3 +812,112 @@ func TestDatabaseBranches_ResizeStatus_NotFound(t *testing.T) {
 	c.Assert(resize, qt.IsNil)
 	c.Assert(err.Error(), qt.Equals, wantError.Error())
 }
+
+func TestDatabaseBranches_WaitForBranchReady(t *testing.T) {
+	c := qt.New(t)
+
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.URL.Path, qt.Equals, "/v1/organizations/my-org/databases/my-db/branches/"+testBranch)
+		polls++
+		w.WriteHeader(200)
+		if polls < 3 {
+			_, _ = w.Write([]byte(`{"id": "branch-1", "name": "` + testBranch + `", "ready": false}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "branch-1", "name": "` + testBranch + `", "ready": true}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var seen []bool
+	branch, err := client.DatabaseBranches.WaitForBranchReady(context.Background(), &WaitForBranchReadyRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       testBranch,
+		Progress: func(b *DatabaseBranch) {
+			seen = append(seen, b.Ready)
+		},
+	}, WithPollInterval(time.Millisecond, time.Millisecond))
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Ready, qt.IsTrue)
+	c.Assert(seen, qt.DeepEquals, []bool{false, false, true})
+}
+
+func TestDatabaseBranches_WaitForBranchReadyTransientErrors(t *testing.T) {
+	tests := []struct {
+		desc      string
+		failures  int
+		tolerance int
+		wantErr   string
+	}{
+		{desc: "tolerated", failures: 2, tolerance: 2},
+		{desc: "exhausted", failures: 3, tolerance: 2, wantErr: "Service Unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			polls := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				polls++
+				switch {
+				case polls == 1:
+					// Not visible yet right after create.
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"code": "not_found", "message": "Not Found"}`))
+				case polls <= tt.failures:
+					w.WriteHeader(http.StatusServiceUnavailable)
+					_, _ = w.Write([]byte(`{"code": "unavailable", "message": "Service Unavailable"}`))
+				default:
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{"id": "branch-1", "name": "` + testBranch + `", "ready": true}`))
+				}
+			}))
+			t.Cleanup(ts.Close)
+
+			client, err := NewClient(WithBaseURL(ts.URL))
+			c.Assert(err, qt.IsNil)
+
+			branch, err := client.DatabaseBranches.WaitForBranchReady(context.Background(), &WaitForBranchReadyRequest{
+				Organization: "my-org",
+				Database:     "my-db",
+				Branch:       testBranch,
+			}, WithPollInterval(time.Millisecond, time.Millisecond), WithErrorTolerance(tt.tolerance))
+			if tt.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, tt.wantErr)
+				c.Assert(polls, qt.Equals, tt.tolerance+1)
+				return
+			}
+			c.Assert(err, qt.IsNil)
+			c.Assert(branch.Ready, qt.IsTrue)
+		})
+	}
+}
+
+func TestDatabaseBranches_WaitForBranchReadyDeadline(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "branch-1", "name": "` + testBranch + `", "ready": false}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	branch, err := client.DatabaseBranches.WaitForBranchReady(ctx, &WaitForBranchReadyRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       testBranch,
+	}, WithPollInterval(time.Millisecond, 5*time.Millisecond))
+	c.Assert(err, qt.ErrorIs, context.DeadlineExceeded)
+	c.Assert(branch.Name, qt.Equals, testBranch)
+	c.Assert(branch.Ready, qt.IsFalse)
+}
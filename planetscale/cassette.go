@@ -0,0 +1,233 @@
+package planetscale
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// CassetteMode selects whether a Cassette talks to the API or replays a
+// recording.
+type CassetteMode int
+
+const (
+	// CassetteReplay serves responses from the recording and never touches
+	// the network. Requests that were not recorded fail.
+	CassetteReplay CassetteMode = iota
+
+	// CassetteRecord sends requests to the API and records every
+	// interaction, replacing the previous recording on Save.
+	CassetteRecord
+
+	// CassetteRecordOnce replays the recording if it exists and records a
+	// new one otherwise.
+	CassetteRecordOnce
+)
+
+// Cassette is an http.RoundTripper that records API interactions to a JSON
+// file and replays them offline, for deterministic tests. Credential headers
+// and secret fields such as plain_text, password and secret are scrubbed
+// before they are stored.
+//
+// Use it as the transport of the HTTP client given to WithHTTPClient, and
+// pass WithHTTPClient before any authentication option so the cassette sees,
+// and scrubs, the Authorization header:
+//
+//	cassette, err := planetscale.NewCassette("testdata/databases.json", planetscale.CassetteReplay, nil)
+//	client, err := planetscale.NewClient(
+//		planetscale.WithHTTPClient(&http.Client{Transport: cassette}),
+//		planetscale.WithServiceToken(tokenID, token),
+//	)
+//	defer cassette.Save()
+type Cassette struct {
+	path      string
+	recording bool
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*CassetteInteraction
+	replayed     []bool
+}
+
+// CassetteInteraction is a recorded request and its response.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request. URL holds the path and query only,
+// so recordings can be replayed against any base URL.
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []*CassetteInteraction `json:"interactions"`
+}
+
+// NewCassette returns a cassette backed by the file at path. In record mode,
+// requests are sent with transport, which defaults to a clean HTTP
+// transport.
+func NewCassette(path string, mode CassetteMode, transport http.RoundTripper) (*Cassette, error) {
+	if transport == nil {
+		transport = cleanhttp.DefaultTransport()
+	}
+	c := &Cassette{path: path, transport: transport}
+
+	switch mode {
+	case CassetteRecord:
+		c.recording = true
+		return c, nil
+	case CassetteReplay, CassetteRecordOnce:
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %d", mode)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && mode == CassetteRecordOnce {
+		c.recording = true
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	var file cassetteFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decoding cassette %s: %w", path, err)
+	}
+	c.interactions = file.Interactions
+	c.replayed = make([]bool, len(file.Interactions))
+	return c, nil
+}
+
+// Recording reports whether the cassette sends requests to the API.
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	recorded := CassetteRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Header: redactHeader(req.Header),
+		Body:   scrubBody(body),
+	}
+
+	if !c.recording {
+		return c.replay(req, recorded)
+	}
+
+	out := req.Clone(req.Context())
+	if req.Body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	res, err := c.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, &CassetteInteraction{
+		Request: recorded,
+		Response: CassetteResponse{
+			StatusCode: res.StatusCode,
+			Header:     redactHeader(res.Header),
+			Body:       scrubBody(resBody),
+		},
+	})
+	c.mu.Unlock()
+
+	return res, nil
+}
+
+// replay returns the response of the first interaction not replayed yet
+// whose request has the same method, URL and body.
+func (c *Cassette) replay(req *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, in := range c.interactions {
+		if c.replayed[i] || in.Request.Method != recorded.Method || in.Request.URL != recorded.URL || in.Request.Body != recorded.Body {
+			continue
+		}
+		c.replayed[i] = true
+
+		header := in.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette %s has no recorded interaction for %s %s", c.path, recorded.Method, recorded.URL)
+}
+
+// Save writes the recorded interactions to the cassette file. It does
+// nothing when replaying.
+func (c *Cassette) Save() error {
+	if !c.recording {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// scrubBody returns body with secret fields redacted. Bodies that are not
+// JSON are recorded as is.
+func scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	out, _ := redactJSON(body)
+	return string(out)
+}
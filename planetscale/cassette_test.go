@@ -0,0 +1,109 @@
+package planetscale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.Header.Get("Authorization"), qt.Equals, "token-id:secret-service-token")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte(`{"id": "pw-1", "name": "my-password", "plain_text": "pscale_pw_secret"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+
+	path := filepath.Join(t.TempDir(), "passwords.json")
+	createReq := &DatabaseBranchPasswordRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Name:         "my-password",
+	}
+
+	recorder, err := NewCassette(path, CassetteRecordOnce, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(recorder.Recording(), qt.IsTrue)
+
+	client, err := NewClient(
+		WithBaseURL(ts.URL),
+		WithHTTPClient(&http.Client{Transport: recorder}),
+		WithServiceToken("token-id", "secret-service-token"),
+	)
+	c.Assert(err, qt.IsNil)
+
+	password, err := client.Passwords.Create(ctx, createReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(password.PlainText, qt.Equals, "pscale_pw_secret")
+	c.Assert(recorder.Save(), qt.IsNil)
+	ts.Close()
+
+	data, err := os.ReadFile(path)
+	c.Assert(err, qt.IsNil)
+	for _, secret := range []string{"secret-service-token", "pscale_pw_secret", "secret-cookie"} {
+		c.Assert(strings.Contains(string(data), secret), qt.IsFalse, qt.Commentf("cassette contains %q", secret))
+	}
+
+	// The server is gone, so the response can only come from the recording.
+	player, err := NewCassette(path, CassetteRecordOnce, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(player.Recording(), qt.IsFalse)
+
+	client, err = NewClient(
+		WithBaseURL("https://api.example.com"),
+		WithHTTPClient(&http.Client{Transport: player}),
+		WithServiceToken("other-id", "other-token"),
+	)
+	c.Assert(err, qt.IsNil)
+
+	password, err = client.Passwords.Create(ctx, createReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(password.PublicID, qt.Equals, "pw-1")
+	c.Assert(password.PlainText, qt.Equals, "REDACTED")
+
+	// Every interaction is replayed once.
+	_, err = client.Passwords.Create(ctx, createReq)
+	c.Assert(err, qt.ErrorMatches, `.*cassette .* has no recorded interaction for POST /v1/organizations/my-org/databases/my-db/branches/main/passwords`)
+}
+
+func TestCassette_ReplayReturnsAPIErrors(t *testing.T) {
+	c := qt.New(t)
+
+	path := filepath.Join(t.TempDir(), "errors.json")
+	err := os.WriteFile(path, []byte(`{
+  "interactions": [
+    {
+      "request": {"method": "GET", "url": "/v1/organizations/my-org/databases/missing"},
+      "response": {"status_code": 404, "body": "{\"code\": \"not_found\", \"message\": \"Not Found\"}"}
+    }
+  ]
+}`), 0o644)
+	c.Assert(err, qt.IsNil)
+
+	cassette, err := NewCassette(path, CassetteReplay, nil)
+	c.Assert(err, qt.IsNil)
+
+	client, err := NewClient(WithHTTPClient(&http.Client{Transport: cassette}))
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Databases.Get(context.Background(), &GetDatabaseRequest{Organization: "my-org", Database: "missing"})
+	c.Assert(err, qt.ErrorIs, &Error{Code: ErrNotFound})
+}
+
+func TestCassette_ReplayRequiresFile(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay, nil)
+	c.Assert(err, qt.ErrorMatches, "reading cassette: .*")
+}
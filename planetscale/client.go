@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"runtime/debug"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"golang.org/x/oauth2"
@@ -23,7 +25,8 @@ const (
 	jsonMediaType  = "application/json"
 )
 
-// ErrorCode defines the code of an error.
+// ErrorCode defines the code of an error. To check whether err carries a
+// code, use errors.Is(err, &Error{Code: ErrNotFound}).
 type ErrorCode string
 
 const (
@@ -33,6 +36,11 @@ const (
 	ErrNotFound          ErrorCode = "not_found"          // Resource not found.
 	ErrRetry             ErrorCode = "retry"              // Operation should be retried.
 	ErrResponseMalformed ErrorCode = "response_malformed" // Response body is malformed.
+	ErrUnauthenticated   ErrorCode = "unauthenticated"    // Missing or invalid credentials (HTTP 401).
+	ErrForbidden         ErrorCode = "forbidden"          // Credentials lack access (HTTP 403).
+	ErrConflict          ErrorCode = "conflict"           // Conflicting resource state (HTTP 409).
+	ErrRateLimited       ErrorCode = "rate_limited"       // Too many requests (HTTP 429).
+	ErrUnavailable       ErrorCode = "unavailable"        // Server error or unavailable (HTTP 5xx), matched by Error.Is.
 )
 
 // Client encapsulates a client that talks to the PlanetScale API
@@ -49,6 +57,39 @@ type Client struct {
 	// base URL for the API
 	baseURL *url.URL
 
+	// defaultOrganization is the organization discovered by
+	// NewClientFromEnvironment.
+	defaultOrganization string
+
+	// retryPolicy configures automatic retries of transient failures. Nil
+	// disables retries.
+	retryPolicy *RetryPolicy
+
+	// rateLimiter paces requests sent by all services. Nil disables client-side
+	// rate limiting.
+	rateLimiter *rateLimiter
+
+	// logger logs every HTTP request. Nil disables logging.
+	logger *slog.Logger
+
+	// readOnly refuses requests other than GETs.
+	readOnly bool
+
+	// dryRun captures requests other than GETs instead of sending them. Nil
+	// sends all requests.
+	dryRun *dryRunPlan
+
+	// journal records every call other than a GET. Nil disables the
+	// journal.
+	journal Journal
+
+	// strictDecoding reports response fields the decoded types don't
+	// declare.
+	strictDecoding bool
+
+	// middleware wraps every API call made through do.
+	middleware []Middleware
+
 	AuditLogs             AuditLogsService
 	AuthAttemptExports    AuthAttemptExportsService
 	BackupPolicies        BackupPoliciesService
@@ -88,6 +129,26 @@ type Client struct {
 // ListOptions are options for listing responses.
 type ListOptions struct {
 	URLValues *url.Values
+
+	// pagination receives the pagination metadata of the response, if set
+	// with WithPaginationInfo.
+	pagination *Pagination
+}
+
+// Pagination holds the pagination metadata of a page-numbered list
+// response. NextPage and PrevPage are nil on the last and first page.
+type Pagination struct {
+	CurrentPage int  `json:"current_page"`
+	NextPage    *int `json:"next_page"`
+	PrevPage    *int `json:"prev_page"`
+}
+
+// setPagination stores p into the destination registered with
+// WithPaginationInfo, if any.
+func (o *ListOptions) setPagination(p Pagination) {
+	if o.pagination != nil {
+		*o.pagination = p
+	}
 }
 
 type ListOption func(*ListOptions) error
@@ -186,6 +247,16 @@ func WithPage(page int) ListOption {
 	}
 }
 
+// WithPaginationInfo returns a ListOption that stores the pagination metadata
+// of the list response into p, e.g. to find out whether a next page exists.
+// List methods that don't report pagination metadata leave p untouched.
+func WithPaginationInfo(p *Pagination) ListOption {
+	return func(opt *ListOptions) error {
+		opt.pagination = p
+		return nil
+	}
+}
+
 // WithPerPage returns a ListOption that sets the "per_page" URL paramter.
 func WithPerPage(perPage int) ListOption {
 	return func(opt *ListOptions) error {
@@ -255,13 +326,7 @@ func WithAccessToken(token string) ClientOption {
 		}
 
 		tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-
-		// make sure we use our own HTTP client
-		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, c.client)
-		oauthClient := oauth2.NewClient(ctx, tokenSource)
-
-		c.client = oauthClient
-		return nil
+		return WithTokenSource(tokenSource)(c)
 	}
 }
 
@@ -360,6 +425,33 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 	return c, nil
 }
 
+// NewRequest creates a request for the API endpoint at path, which is
+// resolved relative to the client's base URL. The request carries the
+// client's headers and User-Agent, and body, if not nil, is encoded as JSON.
+// Together with Do, it allows calling endpoints this package does not cover
+// yet.
+func (c *Client) NewRequest(method, path string, body interface{}, opts ...RequestOption) (*http.Request, error) {
+	return c.newRequest(method, path, body, opts...)
+}
+
+// Do sends a request created by NewRequest with the client's credentials and
+// decodes the JSON response into v, unless v is nil. Error responses are
+// returned as *Error, and the client's retry policy, rate limit, logger and
+// middleware apply as they do to the service methods.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error {
+	return c.do(ctx, req, v)
+}
+
+// Raw calls the API endpoint at path, like `pscale api` does. It is a
+// shorthand for NewRequest followed by Do.
+func (c *Client) Raw(ctx context.Context, method, path string, body, v interface{}) error {
+	req, err := c.newRequest(method, path, body)
+	if err != nil {
+		return fmt.Errorf("error creating http request: %w", err)
+	}
+	return c.do(ctx, req, v)
+}
+
 // do makes an HTTP request and populates the given struct v from the response.
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) error {
 	_, err := c.doWithHeaders(ctx, req, v)
@@ -367,7 +459,53 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) error
 }
 
 func (c *Client) doWithHeaders(ctx context.Context, req *http.Request, v interface{}) (http.Header, error) {
-	res, err := c.client.Do(req.WithContext(ctx))
+	// Refused and planned calls are never made, so they don't reach the
+	// middleware or the journal.
+	if c.readOnly && req.Method != http.MethodGet {
+		return nil, refuseReadOnly(req)
+	}
+	if c.dryRun != nil && req.Method != http.MethodGet {
+		if err := c.dryRun.capture(req); err != nil {
+			return nil, err
+		}
+		return nil, ErrDryRun
+	}
+
+	if c.journal == nil || req.Method == http.MethodGet {
+		return c.handle(ctx, req, v)
+	}
+
+	start := time.Now()
+	headers, err := c.handle(ctx, req, v)
+	c.record(ctx, start, req, v, err)
+	return headers, err
+}
+
+// handle runs req through the client's middleware.
+func (c *Client) handle(ctx context.Context, req *http.Request, v interface{}) (http.Header, error) {
+	if len(c.middleware) == 0 {
+		return c.roundTrip(ctx, req, v)
+	}
+
+	var headers http.Header
+	handler := c.chain(func(ctx context.Context, _ *Operation, v interface{}) error {
+		var err error
+		headers, err = c.roundTrip(ctx, req, v)
+		return err
+	})
+
+	err := handler(ctx, operationFromRequest(req), v)
+	return headers, err
+}
+
+// roundTrip sends req, retrying it if the client has a retry policy, and
+// decodes the response into v.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request, v interface{}) (http.Header, error) {
+	if c.retryPolicy != nil && c.retryPolicy.retryable(req) {
+		return c.doWithRetry(ctx, req, v)
+	}
+
+	res, err := c.send(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -376,10 +514,46 @@ func (c *Client) doWithHeaders(ctx context.Context, req *http.Request, v interfa
 	return res.Header, c.handleResponse(ctx, res, v)
 }
 
+// send waits for the rate limiter, if any, and sends a single HTTP request.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.logger != nil {
+		return c.sendLogged(ctx, req)
+	}
+
+	return c.client.Do(req.WithContext(ctx))
+}
+
 // handleResponse makes an HTTP request and populates the given struct v from
 // the response.  This is meant for internal testing and shouldn't be used
 // directly. Instead please use `Client.do`.
 func (c *Client) handleResponse(ctx context.Context, res *http.Response, v interface{}) error {
+	meta := newResponseMetadata(res)
+	recordResponseMetadata(ctx, meta)
+	if c.rateLimiter != nil {
+		c.rateLimiter.observe(meta, time.Now())
+	}
+
+	err := c.decodeResponse(res, v)
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		apiErr.HTTPStatus = meta.StatusCode
+		apiErr.RequestID = meta.RequestID
+		apiErr.RetryAfter = parseRetryAfter(meta.Header.Get("Retry-After"))
+		apiErr.Response = meta
+	}
+	return err
+}
+
+// decodeResponse reads the response body and either populates v from it or
+// converts it into an *Error.
+func (c *Client) decodeResponse(res *http.Response, v interface{}) error {
 	out, err := io.ReadAll(res.Body)
 	if err != nil {
 		return err
@@ -392,13 +566,18 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 			Message string `json:"message"`
 		}
 
+		// 5xx responses keep the codes they had before ErrUnavailable was
+		// added; Error.Is still matches them against it.
 		statusErrCode := errorCodeForHTTPStatus(res.StatusCode)
+		if statusErrCode == ErrUnavailable {
+			statusErrCode = ""
+		}
 		errorRes := &errorResponse{}
 		err = json.Unmarshal(out, errorRes)
 		if err != nil {
 			var jsonErr *json.SyntaxError
 			if errors.As(err, &jsonErr) {
-				if statusErrCode != "" {
+				if statusErrCode == ErrNotFound {
 					return &Error{
 						msg:  http.StatusText(res.StatusCode),
 						Code: statusErrCode,
@@ -410,9 +589,13 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 					}
 				}
 
+				code := ErrResponseMalformed
+				if statusErrCode != "" {
+					code = statusErrCode
+				}
 				return &Error{
 					msg:  fmt.Sprintf("received HTTP %d with a malformed error response body: %s", res.StatusCode, bodySnippet(out)),
-					Code: ErrResponseMalformed,
+					Code: code,
 					Meta: map[string]string{
 						"body":        string(out),
 						"err":         jsonErr.Error(),
@@ -437,7 +620,7 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 				return err
 			}
 
-			if statusErrCode != "" {
+			if statusErrCode == ErrNotFound {
 				return &Error{
 					msg:  http.StatusText(res.StatusCode),
 					Code: statusErrCode,
@@ -450,9 +633,13 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 
 			// Show the actual response body: it is the only clue to what went
 			// wrong, and burying it in Meta hides it from the user.
+			code := ErrInternal
+			if statusErrCode != "" {
+				code = statusErrCode
+			}
 			return &Error{
 				msg:  fmt.Sprintf("received HTTP %d with an unrecognized error response: %s", res.StatusCode, bodySnippet(out)),
-				Code: ErrInternal,
+				Code: code,
 				Meta: map[string]string{
 					"body":        string(out),
 					"http_status": http.StatusText(res.StatusCode),
@@ -503,6 +690,10 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 		return err
 	}
 
+	if c.strictDecoding {
+		return checkUnknownFields(out, v)
+	}
+
 	return nil
 }
 
@@ -510,7 +701,12 @@ func (c *Client) handleResponse(ctx context.Context, res *http.Response, v inter
 type RequestOption func(*requestOptions)
 
 type requestOptions struct {
-	queryParams url.Values
+	queryParams    url.Values
+	idempotencyKey string
+
+	// service and method name the service method making the request.
+	service string
+	method  string
 }
 
 // WithQueryParams sets query parameters for the request
@@ -568,6 +764,15 @@ func (c *Client) newRequest(method string, path string, body interface{}, opts .
 		req.Header.Set(k, v)
 	}
 
+	if reqOpts.idempotencyKey != "" {
+		req.Header.Set(idempotencyKeyHeader, reqOpts.idempotencyKey)
+	}
+
+	if c.observesOperations() {
+		op := newOperation(req, reqOpts.service, reqOpts.method, body)
+		req = req.WithContext(context.WithValue(req.Context(), operationKey{}, op))
+	}
+
 	return req, nil
 }
 
@@ -632,16 +837,33 @@ func parameterErrorsToError(body []byte) error {
 }
 
 func errorCodeForHTTPStatus(statusCode int) ErrorCode {
-	switch statusCode {
-	case http.StatusNotFound:
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthenticated
+	case statusCode == http.StatusForbidden:
+		return ErrForbidden
+	case statusCode == http.StatusNotFound:
 		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode >= 500:
+		return ErrUnavailable
 	default:
 		return ""
 	}
 }
 
 func (t *serviceTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.Header.Add("Authorization", t.tokenName+":"+t.token)
+	// RoundTrippers must not modify the request: it may be sent again on
+	// retry, and its headers may be logged.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", t.tokenName+":"+t.token)
+	if t.rt == nil {
+		// Like http.Client, fall back to the default transport.
+		return http.DefaultTransport.RoundTrip(req)
+	}
 	return t.rt.RoundTrip(req)
 }
 
@@ -684,11 +906,40 @@ type Error struct {
 	// example, if the Code is "ErrResponseMalformed", the map will be: ["body"]
 	// = "body of the response"
 	Meta map[string]string
+
+	// HTTPStatus is the HTTP status code of the response. Zero when the error
+	// did not originate from an API response.
+	HTTPStatus int
+
+	// RequestID is the ID the API assigned to the failed request.
+	RequestID string
+
+	// RetryAfter is the delay requested by the API with a Retry-After
+	// header, or zero.
+	RetryAfter time.Duration
+
+	// Response describes the API response that caused the error. It is nil
+	// when the error did not originate from an API response.
+	Response *ResponseMetadata
 }
 
 // Error returns the string representation of the error.
 func (e *Error) Error() string { return e.msg }
 
+// Is reports whether the error matches target, an *Error with a Code. The
+// code matches either the error's Code or the code derived from its HTTP
+// status, so a 401 response matches ErrUnauthenticated even when the API
+// reported a more specific code, and a 5xx response matches ErrUnavailable:
+//
+//	if errors.Is(err, &planetscale.Error{Code: planetscale.ErrUnavailable}) {
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok || t.Code == "" {
+		return false
+	}
+	return e.Code == t.Code || (e.HTTPStatus != 0 && errorCodeForHTTPStatus(e.HTTPStatus) == t.Code)
+}
+
 // CursorPaginatedResponse provides a generic means of wrapping a paginated
 // response.
 type CursorPaginatedResponse[T any] struct {
@@ -2,11 +2,15 @@ package planetscale
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	qt "github.com/frankban/quicktest"
 )
@@ -108,6 +112,7 @@ func TestDo(t *testing.T) {
 			},
 			v: &Database{},
 			want: &Database{
+				ID:   "509",
 				Name: "foo-bar",
 			},
 		},
@@ -242,6 +247,111 @@ func TestHandleResponse_UsesStatusCodeForInvalidNotFoundBody(t *testing.T) {
 	}
 }
 
+func TestHandleResponse_ErrorCodesForHTTPStatus(t *testing.T) {
+	tests := []struct {
+		desc       string
+		statusCode int
+		body       string
+		wantCode   ErrorCode
+		wantIs     []ErrorCode
+	}{
+		{
+			desc:       "unauthenticated",
+			statusCode: http.StatusUnauthorized,
+			body:       `{}`,
+			wantCode:   ErrUnauthenticated,
+			wantIs:     []ErrorCode{ErrUnauthenticated},
+		},
+		{
+			desc:       "unauthorized API code keeps permission code",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"code": "unauthorized", "message": "Unauthorized"}`,
+			wantCode:   ErrPermission,
+			wantIs:     []ErrorCode{ErrPermission, ErrUnauthenticated},
+		},
+		{
+			desc:       "forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"code": "forbidden", "message": "Forbidden"}`,
+			wantCode:   ErrForbidden,
+			wantIs:     []ErrorCode{ErrForbidden},
+		},
+		{
+			desc:       "conflict",
+			statusCode: http.StatusConflict,
+			body:       `{"code": "conflict", "message": "Branch already exists"}`,
+			wantCode:   ErrConflict,
+			wantIs:     []ErrorCode{ErrConflict},
+		},
+		{
+			desc:       "rate limited",
+			statusCode: http.StatusTooManyRequests,
+			body:       `not-json`,
+			wantCode:   ErrRateLimited,
+			wantIs:     []ErrorCode{ErrRateLimited},
+		},
+		// 5xx responses keep the codes they had before ErrUnavailable.
+		{
+			desc:       "server error with malformed body",
+			statusCode: http.StatusBadGateway,
+			body:       `<html>Bad Gateway</html>`,
+			wantCode:   ErrResponseMalformed,
+			wantIs:     []ErrorCode{ErrResponseMalformed, ErrUnavailable},
+		},
+		{
+			desc:       "server error with empty body",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{}`,
+			wantCode:   ErrInternal,
+			wantIs:     []ErrorCode{ErrInternal, ErrUnavailable},
+		},
+		{
+			desc:       "server error with unknown API code",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"code": "maintenance", "message": "Down for maintenance"}`,
+			wantCode:   "",
+			wantIs:     []ErrorCode{ErrUnavailable},
+		},
+		{
+			desc:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"code": "not_found", "message": "Not Found"}`,
+			wantCode:   ErrNotFound,
+			wantIs:     []ErrorCode{ErrNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-1")
+				w.Header().Set("Retry-After", "7")
+				w.WriteHeader(tt.statusCode)
+				_, err := w.Write([]byte(tt.body))
+				c.Assert(err, qt.IsNil)
+			}))
+			t.Cleanup(ts.Close)
+
+			client, err := NewClient(WithBaseURL(ts.URL))
+			c.Assert(err, qt.IsNil)
+
+			err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/api-endpoint"), nil)
+
+			var perr *Error
+			c.Assert(errors.As(err, &perr), qt.IsTrue)
+			c.Assert(perr.Code, qt.Equals, tt.wantCode)
+			c.Assert(perr.HTTPStatus, qt.Equals, tt.statusCode)
+			c.Assert(perr.RequestID, qt.Equals, "req-1")
+			c.Assert(perr.RetryAfter, qt.Equals, 7*time.Second)
+			for _, code := range tt.wantIs {
+				c.Assert(errors.Is(err, &Error{Code: code}), qt.IsTrue, qt.Commentf("code %v", code))
+			}
+			c.Assert(errors.Is(err, &Error{Code: ErrInvalid}), qt.IsFalse)
+		})
+	}
+}
+
 func TestSameHostCheckRedirect(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -401,3 +511,48 @@ func mustNewRequest(t *testing.T, client *Client, method, path string) *http.Req
 func Pointer[K any](val K) *K {
 	return &val
 }
+
+func TestClient_Raw(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.Header.Get("Authorization"), qt.Equals, "token-id:token")
+		c.Assert(r.Header.Get("User-Agent"), qt.Equals, "planetscale-go/unknown")
+
+		switch r.URL.Path {
+		case "/v1/organizations/my-org/new-endpoint":
+			c.Assert(r.Method, qt.Equals, http.MethodPost)
+			c.Assert(r.URL.Query().Get("dry_run"), qt.Equals, "true")
+			body, err := io.ReadAll(r.Body)
+			c.Assert(err, qt.IsNil)
+			c.Assert(string(body), qt.Equals, "{\"name\":\"thing\"}\n")
+			_, _ = w.Write([]byte(`{"id": "thing-1"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"code": "forbidden", "message": "not allowed"}`))
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithServiceToken("token-id", "token"))
+	c.Assert(err, qt.IsNil)
+	ctx := context.Background()
+
+	req, err := client.NewRequest(http.MethodPost, "/v1/organizations/my-org/new-endpoint", map[string]string{"name": "thing"},
+		WithQueryParams(url.Values{"dry_run": []string{"true"}}))
+	c.Assert(err, qt.IsNil)
+
+	var out struct {
+		ID string `json:"id"`
+	}
+	c.Assert(client.Do(ctx, req, &out), qt.IsNil)
+	c.Assert(out.ID, qt.Equals, "thing-1")
+
+	err = client.Raw(ctx, http.MethodGet, "v1/organizations/my-org/forbidden", nil, nil)
+	c.Assert(err, qt.ErrorIs, &Error{Code: ErrForbidden})
+
+	var apiErr *Error
+	c.Assert(errors.As(err, &apiErr), qt.IsTrue)
+	c.Assert(apiErr.HTTPStatus, qt.Equals, http.StatusForbidden)
+	c.Assert(apiErr.Error(), qt.Equals, "not allowed")
+}
@@ -74,7 +74,7 @@ func (s *d1ImportNotificationsService) Create(ctx context.Context, req *CreateD1
 	}
 
 	p := path.Join("internal/organizations", req.Organization, "databases", req.Database, "d1-import-notifications")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, body)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, body, withOperation("D1ImportNotifications", "Create"))
 	if err != nil {
 		return err
 	}
@@ -2,7 +2,9 @@ package planetscale
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -35,6 +37,10 @@ type CreateDatabaseRequest struct {
 	CloudflareAccountID string         `json:"cloudflare_account_id,omitempty"`
 	CloudflareTimestamp string         `json:"cloudflare_timestamp,omitempty"`
 	CloudflareSignature string         `json:"cloudflare_signature,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // DatabaseRequest encapsulates the request for getting a single database.
@@ -80,6 +86,7 @@ type DatabasesService interface {
 	Create(context.Context, *CreateDatabaseRequest) (*Database, error)
 	Get(context.Context, *GetDatabaseRequest) (*Database, error)
 	List(context.Context, *ListDatabasesRequest, ...ListOption) ([]*Database, error)
+	All(context.Context, *ListDatabasesRequest, ...ListOption) iter.Seq2[*Database, error]
 	Delete(context.Context, *DeleteDatabaseRequest) (*DatabaseDeletionRequest, error)
 	UpdateSettings(context.Context, *UpdateDatabaseSettingsRequest) (*Database, error)
 }
@@ -105,6 +112,7 @@ const (
 
 // Database represents a PlanetScale database
 type Database struct {
+	ID                         string         `json:"id"`
 	Name                       string         `json:"name"`
 	Notes                      string         `json:"notes"`
 	Region                     Region         `json:"region"`
@@ -124,10 +132,27 @@ type Database struct {
 	MigrationFramework         *string        `json:"migration_framework"`
 	CreatedAt                  time.Time      `json:"created_at"`
 	UpdatedAt                  time.Time      `json:"updated_at"`
+
+	// Extra holds the response fields Database doesn't declare yet, keyed
+	// by JSON name. Since it is a map, databases can't be compared with ==;
+	// ID identifies a database.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the database, keeping undeclared fields in Extra.
+func (d *Database) UnmarshalJSON(data []byte) error {
+	type database Database
+	extra, err := unmarshalWithExtra(data, (*database)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
 }
 
 // Database represents a list of PlanetScale databases
 type databasesResponse struct {
+	Pagination
 	Databases []*Database `json:"data"`
 }
 
@@ -154,7 +179,7 @@ func (ds *databasesService) List(ctx context.Context, listReq *ListDatabasesRequ
 		}
 	}
 
-	req, err := ds.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := ds.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("Databases", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -164,12 +189,21 @@ func (ds *databasesService) List(ctx context.Context, listReq *ListDatabasesRequ
 	if err != nil {
 		return nil, err
 	}
+	defaultOpts.setPagination(dbResponse.Pagination)
 
 	return dbResponse.Databases, nil
 }
 
+// All returns an iterator over all databases of an organization, fetching pages
+// as needed.
+func (ds *databasesService) All(ctx context.Context, listReq *ListDatabasesRequest, opts ...ListOption) iter.Seq2[*Database, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*Database, error) {
+		return ds.List(ctx, listReq, opts...)
+	})
+}
+
 func (ds *databasesService) Create(ctx context.Context, createReq *CreateDatabaseRequest) (*Database, error) {
-	req, err := ds.client.newRequest(http.MethodPost, databasesAPIPath(createReq.Organization), createReq)
+	req, err := ds.client.newRequest(http.MethodPost, databasesAPIPath(createReq.Organization), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("Databases", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for create database: %w", err)
 	}
@@ -185,7 +219,7 @@ func (ds *databasesService) Create(ctx context.Context, createReq *CreateDatabas
 
 func (ds *databasesService) Get(ctx context.Context, getReq *GetDatabaseRequest) (*Database, error) {
 	path := path.Join(databasesAPIPath(getReq.Organization), getReq.Database)
-	req, err := ds.client.newRequest(http.MethodGet, path, nil)
+	req, err := ds.client.newRequest(http.MethodGet, path, nil, withOperation("Databases", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get database: %w", err)
 	}
@@ -201,7 +235,7 @@ func (ds *databasesService) Get(ctx context.Context, getReq *GetDatabaseRequest)
 
 func (ds *databasesService) Delete(ctx context.Context, deleteReq *DeleteDatabaseRequest) (*DatabaseDeletionRequest, error) {
 	path := path.Join(databasesAPIPath(deleteReq.Organization), deleteReq.Database)
-	req, err := ds.client.newRequest(http.MethodDelete, path, nil)
+	req, err := ds.client.newRequest(http.MethodDelete, path, nil, withOperation("Databases", "Delete"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for delete database: %w", err)
 	}
@@ -217,7 +251,7 @@ func (ds *databasesService) Delete(ctx context.Context, deleteReq *DeleteDatabas
 
 func (ds *databasesService) UpdateSettings(ctx context.Context, updateReq *UpdateDatabaseSettingsRequest) (*Database, error) {
 	path := path.Join(databasesAPIPath(updateReq.Organization), updateReq.Database)
-	req, err := ds.client.newRequest(http.MethodPatch, path, updateReq)
+	req, err := ds.client.newRequest(http.MethodPatch, path, updateReq, withOperation("Databases", "UpdateSettings"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for update database settings: %w", err)
 	}
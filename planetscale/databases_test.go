@@ -42,6 +42,7 @@ func TestDatabases_Create(t *testing.T) {
 	})
 
 	want := &Database{
+		ID:    "planetscale-go-test-db",
 		Name:  name,
 		Notes: notes,
 		State: DatabaseReady,
@@ -123,6 +124,7 @@ func TestDatabases_CreatePostgres(t *testing.T) {
 	})
 
 	want := &Database{
+		ID:    "planetscale-go-test-db",
 		Name:  name,
 		Notes: notes,
 		State: DatabaseReady,
@@ -172,6 +174,7 @@ func TestDatabases_CreateWithReplicas(t *testing.T) {
 	})
 
 	want := &Database{
+		ID:    "planetscale-go-test-db",
 		Name:  name,
 		Notes: notes,
 		State: DatabaseReady,
@@ -224,6 +227,7 @@ func TestDatabases_CreateWithReplicasZero(t *testing.T) {
 	})
 
 	want := &Database{
+		ID:    "planetscale-go-test-db",
 		Name:  name,
 		Notes: notes,
 		State: DatabaseReady,
@@ -279,6 +283,7 @@ func TestDatabases_CreateWithStorage(t *testing.T) {
 	})
 
 	want := &Database{
+		ID:    "planetscale-go-test-db",
 		Name:  testDatabase,
 		State: DatabaseReady,
 		Kind:  DatabaseEnginePostgres,
@@ -318,6 +323,7 @@ func TestDatabases_Get(t *testing.T) {
 	})
 
 	want := &Database{
+		ID:        "planetscale-go-test-db",
 		Name:      name,
 		Notes:     notes,
 		CreatedAt: time.Date(2021, time.January, 14, 10, 19, 23, 0, time.UTC),
@@ -351,6 +357,7 @@ func TestDatabases_List(t *testing.T) {
 	})
 
 	want := []*Database{{
+		ID:        "planetscale-go-test-db",
 		Name:      name,
 		Notes:     notes,
 		CreatedAt: time.Date(2021, time.January, 14, 10, 19, 23, 0, time.UTC),
@@ -386,6 +393,7 @@ func TestDatabases_ListWithOptions(t *testing.T) {
 	}, WithPage(2))
 
 	want := []*Database{{
+		ID:        "planetscale-go-test-db",
 		Name:      name,
 		Notes:     notes,
 		CreatedAt: time.Date(2021, time.January, 14, 10, 19, 23, 0, time.UTC),
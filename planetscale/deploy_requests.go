@@ -2,9 +2,10 @@ package planetscale
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
-	"net/url"
 	"path"
 	"time"
 )
@@ -28,8 +29,10 @@ type DeployRequestsService interface {
 	Diff(ctx context.Context, diffReq *DiffRequest) ([]*Diff, error)
 	ForceCutover(context.Context, *ForceCutoverDeployRequestRequest) (*DeployRequest, error)
 	Get(context.Context, *GetDeployRequestRequest) (*DeployRequest, error)
-	List(context.Context, *ListDeployRequestsRequest) ([]*DeployRequest, error)
+	List(context.Context, *ListDeployRequestsRequest, ...ListOption) ([]*DeployRequest, error)
+	All(context.Context, *ListDeployRequestsRequest, ...ListOption) iter.Seq2[*DeployRequest, error]
 	GetDeployOperations(context.Context, *GetDeployOperationsRequest) ([]*DeployOperation, error)
+	WaitForDeployment(context.Context, *WaitForDeploymentRequest, ...WaitOption) (*DeploymentStatus, error)
 	SkipRevertDeploy(context.Context, *SkipRevertDeployRequestRequest) (*DeployRequest, error)
 	RevertDeploy(context.Context, *RevertDeployRequestRequest) (*DeployRequest, error)
 }
@@ -81,6 +84,56 @@ type GetDeployOperationsRequest struct {
 	Number       uint64 `json:"-"`
 }
 
+// WaitForDeploymentRequest encapsulates the request for waiting until the
+// deployment of a deploy request finishes or pauses.
+type WaitForDeploymentRequest struct {
+	Organization string
+	Database     string
+	Number       uint64
+
+	// Progress, if set, is called with the status of the deployment after
+	// every poll.
+	Progress func(*DeploymentStatus)
+}
+
+// DeploymentOutcome tells why WaitForDeployment returned.
+type DeploymentOutcome string
+
+const (
+	// DeploymentOutcomeFinished means the deployment reached a terminal
+	// state that isn't a failure, such as complete or complete_cancel.
+	DeploymentOutcomeFinished DeploymentOutcome = "finished"
+
+	// DeploymentOutcomeFailed means the deployment, its cancellation or its
+	// revert failed. WaitForDeployment returns a *StateError along with it.
+	DeploymentOutcomeFailed DeploymentOutcome = "failed"
+
+	// DeploymentOutcomeAwaitingCutover means the schema changes are ready
+	// and the deployment waits for ApplyDeploy to cut over to them.
+	DeploymentOutcomeAwaitingCutover DeploymentOutcome = "awaiting_cutover"
+
+	// DeploymentOutcomeRevertWindowOpen means the schema changes are live
+	// and can be reverted with RevertDeploy until SkipRevertDeploy is
+	// called or the revert window closes.
+	DeploymentOutcomeRevertWindowOpen DeploymentOutcome = "revert_window_open"
+)
+
+// DeploymentStatus is a snapshot of the deployment of a deploy request.
+type DeploymentStatus struct {
+	DeployRequest *DeployRequest
+
+	// Operations are the schema changes of the deployment, with their
+	// progress and estimated time left.
+	Operations []*DeployOperation
+
+	// QueuePosition is the number of deployments ahead of this one in the
+	// deploy queue.
+	QueuePosition int
+
+	// Outcome is set once the deployment finished or paused.
+	Outcome DeploymentOutcome
+}
+
 // DeployOperation encapsulates a deploy operation within a deployment from the
 // PlanetScale API.
 type DeployOperation struct {
@@ -97,10 +150,10 @@ type DeployOperation struct {
 
 // QueuedDeployment encapsulates a deployment that is in the queue.
 type QueuedDeployment struct {
-	ID                  string `json:"id"`
-	State               string `json:"state"`
-	DeployRequestNumber uint64 `json:"deploy_request_number"`
-	IntoBranch          string `json:"into_branch"`
+	ID                  string          `json:"id"`
+	State               DeploymentState `json:"state"`
+	DeployRequestNumber uint64          `json:"deploy_request_number"`
+	IntoBranch          string          `json:"into_branch"`
 
 	Actor *Actor `json:"actor"`
 
@@ -125,7 +178,7 @@ type DeploymentLintError struct {
 // Deployment encapsulates a deployment for a deploy request.
 type Deployment struct {
 	ID                   string                 `json:"id"`
-	State                string                 `json:"state"`
+	State                DeploymentState        `json:"state"`
 	Deployable           bool                   `json:"deployable"`
 	LintErrors           []*DeploymentLintError `json:"lint_errors"`
 	DeployRequestNumber  uint64                 `json:"deploy_request_number"`
@@ -160,9 +213,9 @@ type DeployRequest struct {
 	BranchDeletedBy *Actor `json:"branch_deleted_by"`
 	Number          uint64 `json:"number"`
 
-	State string `json:"state"`
+	State DeployRequestState `json:"state"`
 
-	DeploymentState string `json:"deployment_state"`
+	DeploymentState DeploymentState `json:"deployment_state"`
 
 	Approved bool `json:"approved"`
 
@@ -176,6 +229,133 @@ type DeployRequest struct {
 	UpdatedAt  time.Time  `json:"updated_at"`
 	ClosedAt   *time.Time `json:"closed_at"`
 	DeployedAt *time.Time `json:"deployed_at"`
+
+	// Extra holds undeclared response fields. Because of it, deploy
+	// requests can't be compared with ==; within a database, Number tells
+	// them apart.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the deploy request, keeping undeclared fields in Extra.
+func (d *DeployRequest) UnmarshalJSON(data []byte) error {
+	type deployRequest DeployRequest
+	extra, err := unmarshalWithExtra(data, (*deployRequest)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+// DeployRequestState is the state of a deploy request.
+type DeployRequestState string
+
+const (
+	DeployRequestOpen   DeployRequestState = "open"
+	DeployRequestClosed DeployRequestState = "closed"
+)
+
+var deployRequestStates = map[DeployRequestState]stateClass{
+	DeployRequestOpen:   stateWaiting,
+	DeployRequestClosed: stateFinished,
+}
+
+// IsKnown reports whether this package declares s.
+func (s DeployRequestState) IsKnown() bool {
+	return deployRequestStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the deploy request is closed.
+func (s DeployRequestState) IsTerminal() bool {
+	return deployRequestStates[s].terminal()
+}
+
+// IsFailed reports whether the deploy request failed. Deploy requests
+// don't fail; their deployments do.
+func (s DeployRequestState) IsFailed() bool {
+	return deployRequestStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the deploy request is being worked on.
+// Deploy requests aren't; their deployments are.
+func (s DeployRequestState) IsInProgress() bool {
+	return deployRequestStates[s] == stateInProgress
+}
+
+// DeploymentState is the state of the deployment of a deploy request.
+type DeploymentState string
+
+const (
+	DeploymentPending                 DeploymentState = "pending"
+	DeploymentReady                   DeploymentState = "ready"
+	DeploymentNoChanges               DeploymentState = "no_changes"
+	DeploymentQueued                  DeploymentState = "queued"
+	DeploymentSubmitting              DeploymentState = "submitting"
+	DeploymentInProgress              DeploymentState = "in_progress"
+	DeploymentInProgressVSchema       DeploymentState = "in_progress_vschema"
+	DeploymentPendingCutover          DeploymentState = "pending_cutover"
+	DeploymentInProgressCutover       DeploymentState = "in_progress_cutover"
+	DeploymentInProgressCancel        DeploymentState = "in_progress_cancel"
+	DeploymentComplete                DeploymentState = "complete"
+	DeploymentCompletePendingRevert   DeploymentState = "complete_pending_revert"
+	DeploymentCompleteCancel          DeploymentState = "complete_cancel"
+	DeploymentCompleteError           DeploymentState = "complete_error"
+	DeploymentInProgressRevert        DeploymentState = "in_progress_revert"
+	DeploymentInProgressRevertVSchema DeploymentState = "in_progress_revert_vschema"
+	DeploymentCompleteRevert          DeploymentState = "complete_revert"
+	DeploymentCompleteRevertError     DeploymentState = "complete_revert_error"
+	DeploymentCancelled               DeploymentState = "cancelled"
+	DeploymentError                   DeploymentState = "error"
+	DeploymentFailed                  DeploymentState = "failed"
+)
+
+var deploymentStates = map[DeploymentState]stateClass{
+	DeploymentPending:                 stateWaiting,
+	DeploymentReady:                   stateWaiting,
+	DeploymentNoChanges:               stateFinished,
+	DeploymentQueued:                  stateWaiting,
+	DeploymentSubmitting:              stateInProgress,
+	DeploymentInProgress:              stateInProgress,
+	DeploymentInProgressVSchema:       stateInProgress,
+	DeploymentPendingCutover:          stateWaiting,
+	DeploymentInProgressCutover:       stateInProgress,
+	DeploymentInProgressCancel:        stateInProgress,
+	DeploymentComplete:                stateFinished,
+	DeploymentCompletePendingRevert:   stateWaiting,
+	DeploymentCompleteCancel:          stateFinished,
+	DeploymentCompleteError:           stateFailed,
+	DeploymentInProgressRevert:        stateInProgress,
+	DeploymentInProgressRevertVSchema: stateInProgress,
+	DeploymentCompleteRevert:          stateFinished,
+	DeploymentCompleteRevertError:     stateFailed,
+	DeploymentCancelled:               stateFinished,
+	DeploymentError:                   stateFailed,
+	DeploymentFailed:                  stateFailed,
+}
+
+// IsKnown reports whether this package declares s.
+func (s DeploymentState) IsKnown() bool {
+	return deploymentStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the deployment finished, successfully or
+// not. DeploymentCompletePendingRevert isn't terminal: the deployment is
+// applied but may still be reverted until the revert is skipped.
+func (s DeploymentState) IsTerminal() bool {
+	return deploymentStates[s].terminal()
+}
+
+// IsFailed reports whether the deployment, its cancellation or its
+// revert failed.
+func (s DeploymentState) IsFailed() bool {
+	return deploymentStates[s] == stateFailed
+}
+
+// IsInProgress reports whether schema changes are being applied,
+// cancelled or reverted. DeploymentPendingCutover isn't in progress: it
+// waits for the user to apply the cutover.
+func (s DeploymentState) IsInProgress() bool {
+	return deploymentStates[s] == stateInProgress
 }
 
 type ApplyDeployRequestRequest struct {
@@ -211,6 +391,10 @@ type CreateDeployRequestRequest struct {
 	Notes            string `json:"notes"`
 	AutoCutover      bool   `json:"auto_cutover,omitempty"`
 	AutoDeleteBranch bool   `json:"auto_delete_branch,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 type SkipRevertDeployRequestRequest struct {
@@ -273,7 +457,7 @@ func NewDeployRequestsService(client *Client) *deployRequestsService {
 
 // Get fetches a single deploy request.
 func (d *deployRequestsService) Get(ctx context.Context, getReq *GetDeployRequestRequest) (*DeployRequest, error) {
-	req, err := d.client.newRequest(http.MethodGet, deployRequestAPIPath(getReq.Organization, getReq.Database, getReq.Number), nil)
+	req, err := d.client.newRequest(http.MethodGet, deployRequestAPIPath(getReq.Organization, getReq.Database, getReq.Number), nil, withOperation("DeployRequests", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -296,7 +480,7 @@ func (d *deployRequestsService) CloseDeploy(ctx context.Context, closeReq *Close
 		State: "closed",
 	}
 
-	req, err := d.client.newRequest(http.MethodPatch, deployRequestAPIPath(closeReq.Organization, closeReq.Database, closeReq.Number), updateReq)
+	req, err := d.client.newRequest(http.MethodPatch, deployRequestAPIPath(closeReq.Organization, closeReq.Database, closeReq.Number), updateReq, withOperation("DeployRequests", "CloseDeploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -312,7 +496,7 @@ func (d *deployRequestsService) CloseDeploy(ctx context.Context, closeReq *Close
 // Deploy approves and executes a specific deploy request.
 func (d *deployRequestsService) Deploy(ctx context.Context, deployReq *PerformDeployRequest) (*DeployRequest, error) {
 	path := deployRequestActionAPIPath(deployReq.Organization, deployReq.Database, deployReq.Number, "deploy")
-	req, err := d.client.newRequest(http.MethodPost, path, deployReq)
+	req, err := d.client.newRequest(http.MethodPost, path, deployReq, withOperation("DeployRequests", "Deploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -326,12 +510,13 @@ func (d *deployRequestsService) Deploy(ctx context.Context, deployReq *PerformDe
 }
 
 type deployRequestsResponse struct {
+	Pagination
 	DeployRequests []*DeployRequest `json:"data"`
 }
 
 func (d *deployRequestsService) Create(ctx context.Context, createReq *CreateDeployRequestRequest) (*DeployRequest, error) {
 	path := deployRequestsAPIPath(createReq.Organization, createReq.Database)
-	req, err := d.client.newRequest(http.MethodPost, path, createReq)
+	req, err := d.client.newRequest(http.MethodPost, path, createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("DeployRequests", "Create"))
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +531,7 @@ func (d *deployRequestsService) Create(ctx context.Context, createReq *CreateDep
 // CancelDeploy cancels a queued deploy request.
 func (d *deployRequestsService) CancelDeploy(ctx context.Context, deployReq *CancelDeployRequestRequest) (*DeployRequest, error) {
 	path := deployRequestActionAPIPath(deployReq.Organization, deployReq.Database, deployReq.Number, "cancel")
-	req, err := d.client.newRequest(http.MethodPost, path, deployReq)
+	req, err := d.client.newRequest(http.MethodPost, path, deployReq, withOperation("DeployRequests", "CancelDeploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -361,7 +546,7 @@ func (d *deployRequestsService) CancelDeploy(ctx context.Context, deployReq *Can
 
 func (d *deployRequestsService) ApplyDeploy(ctx context.Context, applyReq *ApplyDeployRequestRequest) (*DeployRequest, error) {
 	path := deployRequestActionAPIPath(applyReq.Organization, applyReq.Database, applyReq.Number, "apply-deploy")
-	req, err := d.client.newRequest(http.MethodPost, path, applyReq)
+	req, err := d.client.newRequest(http.MethodPost, path, applyReq, withOperation("DeployRequests", "ApplyDeploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -377,7 +562,7 @@ func (d *deployRequestsService) ApplyDeploy(ctx context.Context, applyReq *Apply
 // ForceCutover requests a force cutover for a deploy request stuck in the cutover phase.
 func (d *deployRequestsService) ForceCutover(ctx context.Context, forceReq *ForceCutoverDeployRequestRequest) (*DeployRequest, error) {
 	path := deployRequestActionAPIPath(forceReq.Organization, forceReq.Database, forceReq.Number, "force-cutover")
-	req, err := d.client.newRequest(http.MethodPost, path, forceReq)
+	req, err := d.client.newRequest(http.MethodPost, path, forceReq, withOperation("DeployRequests", "ForceCutover"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -398,7 +583,7 @@ func (d *deployRequestsService) AutoApplyDeploy(ctx context.Context, autoApplyRe
 	}
 
 	path := deployRequestActionAPIPath(autoApplyReq.Organization, autoApplyReq.Database, autoApplyReq.Number, "auto-apply")
-	req, err := d.client.newRequest(http.MethodPut, path, reqBody)
+	req, err := d.client.newRequest(http.MethodPut, path, reqBody, withOperation("DeployRequests", "AutoApplyDeploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -414,7 +599,7 @@ func (d *deployRequestsService) AutoApplyDeploy(ctx context.Context, autoApplyRe
 // SkipRevert skips a pending revert of a completed deploy request
 func (d *deployRequestsService) SkipRevertDeploy(ctx context.Context, deployReq *SkipRevertDeployRequestRequest) (*DeployRequest, error) {
 	path := deployRequestActionAPIPath(deployReq.Organization, deployReq.Database, deployReq.Number, "skip-revert")
-	req, err := d.client.newRequest(http.MethodPost, path, deployReq)
+	req, err := d.client.newRequest(http.MethodPost, path, deployReq, withOperation("DeployRequests", "SkipRevertDeploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -430,7 +615,7 @@ func (d *deployRequestsService) SkipRevertDeploy(ctx context.Context, deployReq
 // RevertDeploy reverts a completed deploy request
 func (d *deployRequestsService) RevertDeploy(ctx context.Context, deployReq *RevertDeployRequestRequest) (*DeployRequest, error) {
 	path := deployRequestActionAPIPath(deployReq.Organization, deployReq.Database, deployReq.Number, "revert")
-	req, err := d.client.newRequest(http.MethodPost, path, deployReq)
+	req, err := d.client.newRequest(http.MethodPost, path, deployReq, withOperation("DeployRequests", "RevertDeploy"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -466,6 +651,7 @@ func (d *deployRequestsService) Diff(ctx context.Context, diffReq *DiffRequest)
 		http.MethodGet,
 		deployRequestActionAPIPath(diffReq.Organization, diffReq.Database, diffReq.Number, "diff"),
 		nil,
+		withOperation("DeployRequests", "Diff"),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
@@ -479,10 +665,11 @@ func (d *deployRequestsService) Diff(ctx context.Context, diffReq *DiffRequest)
 	return diffs.Diffs, nil
 }
 
-func (d *deployRequestsService) List(ctx context.Context, listReq *ListDeployRequestsRequest) ([]*DeployRequest, error) {
+func (d *deployRequestsService) List(ctx context.Context, listReq *ListDeployRequestsRequest, opts ...ListOption) ([]*DeployRequest, error) {
 	baseURL := deployRequestsAPIPath(listReq.Organization, listReq.Database)
 
-	queryParams := url.Values{}
+	listOpts := defaultListOptions()
+	queryParams := listOpts.URLValues
 	if listReq.State != "" {
 		queryParams.Set("state", listReq.State)
 	}
@@ -492,8 +679,13 @@ func (d *deployRequestsService) List(ctx context.Context, listReq *ListDeployReq
 	if listReq.IntoBranch != "" {
 		queryParams.Set("into_branch", listReq.IntoBranch)
 	}
+	for _, opt := range opts {
+		if err := opt(listOpts); err != nil {
+			return nil, err
+		}
+	}
 
-	req, err := d.client.newRequest(http.MethodGet, baseURL, nil, WithQueryParams(queryParams))
+	req, err := d.client.newRequest(http.MethodGet, baseURL, nil, WithQueryParams(*queryParams), withOperation("DeployRequests", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -502,10 +694,19 @@ func (d *deployRequestsService) List(ctx context.Context, listReq *ListDeployReq
 	if err := d.client.do(ctx, req, &drReq); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(drReq.Pagination)
 
 	return drReq.DeployRequests, nil
 }
 
+// All returns an iterator over all deploy requests of a database, fetching
+// pages as needed.
+func (d *deployRequestsService) All(ctx context.Context, listReq *ListDeployRequestsRequest, opts ...ListOption) iter.Seq2[*DeployRequest, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*DeployRequest, error) {
+		return d.List(ctx, listReq, opts...)
+	})
+}
+
 func (d *deployRequestsService) CreateReview(ctx context.Context, reviewReq *ReviewDeployRequestRequest) (*DeployRequestReview, error) {
 	reqBody := struct {
 		State string `json:"state"`
@@ -521,7 +722,7 @@ func (d *deployRequestsService) CreateReview(ctx context.Context, reviewReq *Rev
 			reviewReq.Database,
 			reviewReq.Number,
 			"reviews",
-		), reqBody)
+		), reqBody, withOperation("DeployRequests", "CreateReview"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -539,7 +740,7 @@ type deployOperationResponse struct {
 }
 
 func (d *deployRequestsService) GetDeployOperations(ctx context.Context, getReq *GetDeployOperationsRequest) ([]*DeployOperation, error) {
-	req, err := d.client.newRequest(http.MethodGet, deployRequestActionAPIPath(getReq.Organization, getReq.Database, getReq.Number, "operations"), nil)
+	req, err := d.client.newRequest(http.MethodGet, deployRequestActionAPIPath(getReq.Organization, getReq.Database, getReq.Number, "operations"), nil, withOperation("DeployRequests", "GetDeployOperations"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -552,6 +753,70 @@ func (d *deployRequestsService) GetDeployOperations(ctx context.Context, getReq
 	return resp.Ops, nil
 }
 
+// WaitForDeployment polls the deploy request and its deploy operations
+// until the deployment finishes, fails or pauses for the user, and returns
+// its last status. Pauses are reported with DeploymentOutcomeAwaitingCutover
+// and DeploymentOutcomeRevertWindowOpen. A failed deployment is reported with
+// DeploymentOutcomeFailed and a *StateError, as is a deploy request closed
+// while its deployment waits. If ctx is done first, WaitForDeployment returns ctx's error along
+// with the last status seen.
+//
+// Deployments that are pending or ready wait for Deploy to be called, so
+// call WaitForDeployment after it; otherwise it waits until ctx is done.
+func (d *deployRequestsService) WaitForDeployment(ctx context.Context, waitReq *WaitForDeploymentRequest, opts ...WaitOption) (*DeploymentStatus, error) {
+	var status *DeploymentStatus
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		dr, err := d.Get(ctx, &GetDeployRequestRequest{
+			Organization: waitReq.Organization,
+			Database:     waitReq.Database,
+			Number:       waitReq.Number,
+		})
+		if err != nil {
+			return false, err
+		}
+		ops, err := d.GetDeployOperations(ctx, &GetDeployOperationsRequest{
+			Organization: waitReq.Organization,
+			Database:     waitReq.Database,
+			Number:       waitReq.Number,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		status = &DeploymentStatus{DeployRequest: dr, Operations: ops}
+		if dr.Deployment != nil {
+			status.QueuePosition = len(dr.Deployment.PrecedingDeployments)
+		}
+
+		state := dr.DeploymentState
+		switch {
+		case state == DeploymentPendingCutover:
+			status.Outcome = DeploymentOutcomeAwaitingCutover
+		case state == DeploymentCompletePendingRevert:
+			status.Outcome = DeploymentOutcomeRevertWindowOpen
+		case state.IsFailed():
+			status.Outcome = DeploymentOutcomeFailed
+		case state.IsTerminal():
+			status.Outcome = DeploymentOutcomeFinished
+		}
+		if waitReq.Progress != nil {
+			waitReq.Progress(status)
+		}
+
+		resource := fmt.Sprintf("deploy request %d", waitReq.Number)
+		if state.IsFailed() {
+			return false, &StateError{Resource: resource, State: string(state)}
+		}
+		if status.Outcome == "" && !state.IsInProgress() && dr.State == DeployRequestClosed {
+			// Nobody can deploy a closed deploy request anymore, but a
+			// revert or cancellation under way still finishes.
+			return false, &StateError{Resource: resource, State: string(dr.State)}
+		}
+		return status.Outcome != "", nil
+	})
+	return status, err
+}
+
 func deployRequestsAPIPath(org, db string) string {
 	return path.Join(databasesAPIPath(org), db, "deploy-requests")
 }
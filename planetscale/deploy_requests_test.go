@@ -489,6 +489,41 @@ func TestDeployRequests_ListQueryParams(t *testing.T) {
 	c.Assert(receivedQueryParams.Get("into_branch"), qt.Equals, "main")
 }
 
+func TestDeployRequests_ListWithPagination(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.URL.Query().Get("state"), qt.Equals, "open")
+		c.Assert(r.URL.Query().Get("page"), qt.Equals, "2")
+		c.Assert(r.URL.Query().Get("per_page"), qt.Equals, "25")
+
+		w.WriteHeader(200)
+		out := `{"current_page": 2, "next_page": 3, "prev_page": 1, "data": [{"id": "test-deploy-request-id", "number": 26}]}`
+		_, err := w.Write([]byte(out))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var pagination Pagination
+	requests, err := client.DeployRequests.List(context.Background(), &ListDeployRequestsRequest{
+		Organization: testOrg,
+		Database:     testDatabase,
+		State:        "open",
+	}, WithPage(2), WithPerPage(25), WithPaginationInfo(&pagination))
+
+	c.Assert(err, qt.IsNil)
+	c.Assert(requests, qt.HasLen, 1)
+	c.Assert(requests[0].Number, qt.Equals, uint64(26))
+	c.Assert(pagination, qt.DeepEquals, Pagination{
+		CurrentPage: 2,
+		NextPage:    Pointer(3),
+		PrevPage:    Pointer(1),
+	})
+}
+
 func TestDeployRequests_SkipRevertDeploy(t *testing.T) {
 	c := qt.New(t)
 
@@ -623,3 +658,155 @@ func TestDeployRequests_DeployOperations(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(do, qt.DeepEquals, want)
 }
+
+// deploymentServer serves a deploy request whose deployment moves through
+// states, one per poll, along with its deploy operations.
+func deploymentServer(t *testing.T, states ...string) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if strings.HasSuffix(r.URL.Path, "/operations") {
+			_, _ = w.Write([]byte(`{"data": [{"id": "op-1", "state": "in_progress", "table_name": "users", "progress_percentage": 50, "eta_seconds": 30}]}`))
+			return
+		}
+
+		state := states[0]
+		if len(states) > 1 {
+			states = states[1:]
+		}
+		preceding := `[]`
+		if state == "queued" {
+			preceding = `[{"id": "d-0", "state": "in_progress"}, {"id": "d-1", "state": "queued"}]`
+		}
+		_, _ = w.Write([]byte(`{"id": "dr-1", "number": 7, "deployment_state": "` + state + `", "deployment": {"id": "d-2", "state": "` + state + `", "preceding_deployments": ` + preceding + `}}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestDeployRequests_WaitForDeployment(t *testing.T) {
+	c := qt.New(t)
+
+	ts := deploymentServer(t, "queued", "in_progress", "pending_cutover")
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var queue []int
+	var states []DeploymentState
+	status, err := client.DeployRequests.WaitForDeployment(context.Background(), &WaitForDeploymentRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Number:       7,
+		Progress: func(s *DeploymentStatus) {
+			queue = append(queue, s.QueuePosition)
+			states = append(states, s.DeployRequest.DeploymentState)
+		},
+	}, WithPollInterval(time.Millisecond, time.Millisecond))
+	c.Assert(err, qt.IsNil)
+	c.Assert(status.Outcome, qt.Equals, DeploymentOutcomeAwaitingCutover)
+	c.Assert(status.Operations, qt.HasLen, 1)
+	c.Assert(status.Operations[0].ProgressPercentage, qt.Equals, uint64(50))
+	c.Assert(status.Operations[0].ETASeconds, qt.Equals, int64(30))
+	c.Assert(queue, qt.DeepEquals, []int{2, 0, 0})
+	c.Assert(states, qt.DeepEquals, []DeploymentState{DeploymentQueued, DeploymentInProgress, DeploymentPendingCutover})
+}
+
+func TestDeployRequests_WaitForDeploymentOutcomes(t *testing.T) {
+	tests := []struct {
+		state   string
+		outcome DeploymentOutcome
+		err     string
+	}{
+		{state: "complete_pending_revert", outcome: DeploymentOutcomeRevertWindowOpen},
+		{state: "complete", outcome: DeploymentOutcomeFinished},
+		{state: "complete_cancel", outcome: DeploymentOutcomeFinished},
+		{state: "complete_error", outcome: DeploymentOutcomeFailed, err: "deploy request 7 is in state complete_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			c := qt.New(t)
+
+			ts := deploymentServer(t, "in_progress", tt.state)
+			client, err := NewClient(WithBaseURL(ts.URL))
+			c.Assert(err, qt.IsNil)
+
+			status, err := client.DeployRequests.WaitForDeployment(context.Background(), &WaitForDeploymentRequest{
+				Organization: "my-org",
+				Database:     "my-db",
+				Number:       7,
+			}, WithPollInterval(time.Millisecond, time.Millisecond))
+			if tt.err != "" {
+				var stateErr *StateError
+				c.Assert(err, qt.ErrorAs, &stateErr)
+				c.Assert(err, qt.ErrorMatches, tt.err)
+			} else {
+				c.Assert(err, qt.IsNil)
+			}
+			c.Assert(status.Outcome, qt.Equals, tt.outcome)
+		})
+	}
+}
+
+func TestDeployRequests_WaitForDeploymentClosed(t *testing.T) {
+	tests := []struct {
+		desc      string
+		states    []string
+		wantPolls int
+		outcome   DeploymentOutcome
+		err       string
+	}{
+		{
+			desc:      "waiting",
+			states:    []string{"ready"},
+			wantPolls: 1,
+			err:       "deploy request 7 is in state closed",
+		},
+		{
+			desc:      "reverting",
+			states:    []string{"in_progress_revert", "complete_revert"},
+			wantPolls: 2,
+			outcome:   DeploymentOutcomeFinished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			states, polls := tt.states, 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				if strings.HasSuffix(r.URL.Path, "/operations") {
+					_, _ = w.Write([]byte(`{"data": []}`))
+					return
+				}
+				polls++
+				state := states[0]
+				if len(states) > 1 {
+					states = states[1:]
+				}
+				_, _ = w.Write([]byte(`{"id": "dr-1", "number": 7, "state": "closed", "deployment_state": "` + state + `", "deployment": {"id": "d-2", "state": "` + state + `"}}`))
+			}))
+			t.Cleanup(ts.Close)
+
+			client, err := NewClient(WithBaseURL(ts.URL))
+			c.Assert(err, qt.IsNil)
+
+			status, err := client.DeployRequests.WaitForDeployment(context.Background(), &WaitForDeploymentRequest{
+				Organization: "my-org",
+				Database:     "my-db",
+				Number:       7,
+			}, WithPollInterval(time.Millisecond, time.Millisecond))
+			if tt.err != "" {
+				var stateErr *StateError
+				c.Assert(err, qt.ErrorAs, &stateErr)
+				c.Assert(err, qt.ErrorMatches, tt.err)
+			} else {
+				c.Assert(err, qt.IsNil)
+			}
+			c.Assert(status.DeployRequest.State, qt.Equals, DeployRequestClosed)
+			c.Assert(status.Outcome, qt.Equals, tt.outcome)
+			c.Assert(polls, qt.Equals, tt.wantPolls)
+		})
+	}
+}
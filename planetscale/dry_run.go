@@ -0,0 +1,92 @@
+package planetscale
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// ErrDryRun is returned by calls that change resources when the client was
+// created with WithDryRun. The request is added to the client's plan instead
+// of being sent.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// PlannedRequest is a request captured by a dry-run client.
+type PlannedRequest struct {
+	// Service and Method name the client method that built the request,
+	// e.g. "Databases" and "Delete". They are empty for requests made with
+	// Client.Do.
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+
+	HTTPMethod string          `json:"http_method"`
+	Path       string          `json:"path"`
+	Query      url.Values      `json:"query,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+// WithDryRun configures the client to capture every request other than a
+// GET into a plan, returned by Client.Plan, instead of sending it. Such
+// calls fail with ErrDryRun, while GET requests are sent as usual, so
+// scripts can show what they would change before running for real. Planned
+// calls don't reach middleware or the journal.
+func WithDryRun() ClientOption {
+	return func(c *Client) error {
+		c.dryRun = &dryRunPlan{}
+		return nil
+	}
+}
+
+type dryRunPlan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Plan returns the requests captured by a client created with WithDryRun,
+// in the order they were made.
+func (c *Client) Plan() []PlannedRequest {
+	if c.dryRun == nil {
+		return nil
+	}
+
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+	return append([]PlannedRequest(nil), c.dryRun.requests...)
+}
+
+// capture adds req to the plan.
+func (p *dryRunPlan) capture(req *http.Request) error {
+	op := operationFromRequest(req)
+	planned := PlannedRequest{
+		Service:    op.Service,
+		Method:     op.Method,
+		HTTPMethod: req.Method,
+		Path:       req.URL.Path,
+	}
+	if query := req.URL.Query(); len(query) > 0 {
+		planned.Query = query
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return err
+		}
+		if len(data) > 0 && json.Valid(data) {
+			planned.Body = json.RawMessage(data)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, planned)
+	return nil
+}
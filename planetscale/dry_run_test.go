@@ -0,0 +1,89 @@
+package planetscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDryRun(t *testing.T) {
+	c := qt.New(t)
+
+	var methods []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id": "branch-1", "name": "main"}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithDryRun())
+	c.Assert(err, qt.IsNil)
+	ctx := context.Background()
+
+	// Reads are sent.
+	branch, err := client.DatabaseBranches.Get(ctx, &GetDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "main"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Name, qt.Equals, "main")
+
+	// Mutations are planned.
+	_, err = client.DatabaseBranches.Create(ctx, &CreateDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Name: "feature", ParentBranch: "main"})
+	c.Assert(err, qt.ErrorIs, ErrDryRun)
+	err = client.DatabaseBranches.Delete(ctx, &DeleteDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "feature", DeleteDescendants: true})
+	c.Assert(err, qt.ErrorIs, ErrDryRun)
+
+	c.Assert(methods, qt.DeepEquals, []string{http.MethodGet})
+
+	plan := client.Plan()
+	c.Assert(plan, qt.HasLen, 2)
+
+	c.Assert(plan[0].Service, qt.Equals, "DatabaseBranches")
+	c.Assert(plan[0].Method, qt.Equals, "Create")
+	c.Assert(plan[0].HTTPMethod, qt.Equals, http.MethodPost)
+	c.Assert(plan[0].Path, qt.Equals, "/v1/organizations/my-org/databases/my-db/branches")
+	var body map[string]interface{}
+	c.Assert(json.Unmarshal(plan[0].Body, &body), qt.IsNil)
+	c.Assert(body["name"], qt.Equals, "feature")
+	c.Assert(body["parent_branch"], qt.Equals, "main")
+
+	c.Assert(plan[1], qt.DeepEquals, PlannedRequest{
+		Service:    "DatabaseBranches",
+		Method:     "Delete",
+		HTTPMethod: http.MethodDelete,
+		Path:       "/v1/organizations/my-org/databases/my-db/branches/feature",
+		Query:      url.Values{"delete_descendants": {"true"}},
+	})
+}
+
+func TestDryRun_SkipsMiddlewareAndJournal(t *testing.T) {
+	c := qt.New(t)
+
+	var (
+		journal bytes.Buffer
+		ops     []string
+	)
+	client, err := NewClient(
+		WithBaseURL("http://127.0.0.1:0"),
+		WithDryRun(),
+		WithJournal(NewJSONLinesJournal(&journal)),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, op *Operation, v interface{}) error {
+				ops = append(ops, op.Method)
+				return next(ctx, op, v)
+			}
+		}),
+	)
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.DatabaseBranches.Create(context.Background(), &CreateDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Name: "feature", ParentBranch: "main"})
+	c.Assert(err, qt.ErrorIs, ErrDryRun)
+	c.Assert(client.Plan(), qt.HasLen, 1)
+	c.Assert(ops, qt.HasLen, 0)
+	c.Assert(journal.Len(), qt.Equals, 0)
+}
@@ -0,0 +1,162 @@
+package planetscale
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Environment variables read by NewClientFromEnvironment.
+const (
+	EnvServiceTokenID = "PLANETSCALE_SERVICE_TOKEN_ID"
+	EnvServiceToken   = "PLANETSCALE_SERVICE_TOKEN"
+	EnvAccessToken    = "PLANETSCALE_ACCESS_TOKEN"
+	EnvAPIURL         = "PLANETSCALE_API_URL"
+	EnvOrganization   = "PLANETSCALE_ORG"
+)
+
+const (
+	pscaleConfigFile      = "pscale.yml"
+	pscaleAccessTokenFile = "access-token"
+)
+
+// NewClientFromEnvironment instantiates a client with credentials discovered
+// from the environment, in this order:
+//
+//  1. a service token from PLANETSCALE_SERVICE_TOKEN_ID and
+//     PLANETSCALE_SERVICE_TOKEN,
+//  2. an access token from PLANETSCALE_ACCESS_TOKEN,
+//  3. a service token from the service-token-id and service-token keys of
+//     the pscale CLI config file, ~/.config/planetscale/pscale.yml,
+//  4. the access token stored by `pscale auth login` in
+//     ~/.config/planetscale/access-token.
+//
+// The base URL is read from PLANETSCALE_API_URL, and the default
+// organization, returned by Client.DefaultOrganization, from PLANETSCALE_ORG
+// or the org key of the config file. The given options are applied after
+// these, so they take precedence, but before the discovered credentials, so
+// the credentials also apply to an HTTP client set with WithHTTPClient. To
+// use credentials of your own, call NewClient instead.
+func NewClientFromEnvironment(opts ...ClientOption) (*Client, error) {
+	config, err := readPscaleConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var envOpts []ClientOption
+	if apiURL := os.Getenv(EnvAPIURL); apiURL != "" {
+		envOpts = append(envOpts, WithBaseURL(apiURL))
+	}
+
+	org := os.Getenv(EnvOrganization)
+	if org == "" {
+		org = config["org"]
+	}
+	if org != "" {
+		envOpts = append(envOpts, withDefaultOrganization(org))
+	}
+
+	auth, err := credentialsFromEnvironment(config)
+	if err != nil {
+		return nil, err
+	}
+	envOpts = append(envOpts, opts...)
+
+	return NewClient(append(envOpts, auth)...)
+}
+
+// DefaultOrganization returns the organization discovered by
+// NewClientFromEnvironment, or an empty string.
+func (c *Client) DefaultOrganization() string {
+	return c.defaultOrganization
+}
+
+func withDefaultOrganization(org string) ClientOption {
+	return func(c *Client) error {
+		c.defaultOrganization = org
+		return nil
+	}
+}
+
+func credentialsFromEnvironment(config map[string]string) (ClientOption, error) {
+	tokenID, token := os.Getenv(EnvServiceTokenID), os.Getenv(EnvServiceToken)
+	switch {
+	case tokenID != "" && token != "":
+		return WithServiceToken(tokenID, token), nil
+	case tokenID != "" || token != "":
+		return nil, fmt.Errorf("both %s and %s must be set", EnvServiceTokenID, EnvServiceToken)
+	}
+
+	if accessToken := os.Getenv(EnvAccessToken); accessToken != "" {
+		return WithAccessToken(accessToken), nil
+	}
+
+	if tokenID, token := config["service-token-id"], config["service-token"]; tokenID != "" && token != "" {
+		return WithServiceToken(tokenID, token), nil
+	}
+
+	if dir, err := pscaleConfigDir(); err == nil {
+		accessToken, err := os.ReadFile(filepath.Join(dir, pscaleAccessTokenFile))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("reading pscale access token: %w", err)
+		}
+		if token := string(bytes.TrimSpace(accessToken)); token != "" {
+			return WithAccessToken(token), nil
+		}
+	}
+
+	return nil, fmt.Errorf("no PlanetScale credentials found: set %s and %s, or %s, or run `pscale auth login`",
+		EnvServiceTokenID, EnvServiceToken, EnvAccessToken)
+}
+
+func pscaleConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("finding pscale config directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "planetscale"), nil
+}
+
+// readPscaleConfig reads the top-level keys of the pscale CLI config file.
+// The file is flat YAML, so only "key: value" lines are parsed. A missing
+// file yields an empty config.
+func readPscaleConfig() (map[string]string, error) {
+	config := make(map[string]string)
+
+	dir, err := pscaleConfigDir()
+	if err != nil {
+		return config, nil
+	}
+	data, err := os.ReadFile(filepath.Join(dir, pscaleConfigFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return config, nil
+		}
+		return nil, fmt.Errorf("reading pscale config: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == ' ' || line[0] == '\t' || line[0] == '#' {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+		config[strings.TrimSpace(key)] = strings.Trim(value, `"'`)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading pscale config: %w", err)
+	}
+	return config, nil
+}
@@ -0,0 +1,146 @@
+package planetscale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func setupEnvironment(t *testing.T, env map[string]string, files map[string]string) {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	for _, key := range []string{EnvServiceTokenID, EnvServiceToken, EnvAccessToken, EnvAPIURL, EnvOrganization} {
+		t.Setenv(key, env[key])
+	}
+
+	dir := filepath.Join(home, ".config", "planetscale")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewClientFromEnvironment(t *testing.T) {
+	tests := []struct {
+		desc     string
+		env      map[string]string
+		files    map[string]string
+		wantAuth string
+		wantOrg  string
+	}{
+		{
+			desc: "service token from environment wins",
+			env: map[string]string{
+				EnvServiceTokenID: "env-id",
+				EnvServiceToken:   "env-token",
+				EnvAccessToken:    "env-access-token",
+			},
+			files:    map[string]string{"access-token": "file-access-token"},
+			wantAuth: "env-id:env-token",
+		},
+		{
+			desc: "access token from environment",
+			env: map[string]string{
+				EnvAccessToken:  "env-access-token",
+				EnvOrganization: "env-org",
+			},
+			files:    map[string]string{"pscale.yml": "org: file-org\n"},
+			wantAuth: "Bearer env-access-token",
+			wantOrg:  "env-org",
+		},
+		{
+			desc: "service token from config file",
+			files: map[string]string{
+				"pscale.yml":   "org: file-org\nservice-token-id: file-id\nservice-token: \"file-token\"\n",
+				"access-token": "file-access-token",
+			},
+			wantAuth: "file-id:file-token",
+			wantOrg:  "file-org",
+		},
+		{
+			desc:     "access token file",
+			files:    map[string]string{"access-token": "file-access-token\n"},
+			wantAuth: "Bearer file-access-token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			var gotAuth string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAuth = r.Header.Get("Authorization")
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			t.Cleanup(ts.Close)
+
+			env := map[string]string{EnvAPIURL: ts.URL}
+			for k, v := range tt.env {
+				env[k] = v
+			}
+			setupEnvironment(t, env, tt.files)
+
+			client, err := NewClientFromEnvironment()
+			c.Assert(err, qt.IsNil)
+			c.Assert(client.DefaultOrganization(), qt.Equals, tt.wantOrg)
+
+			err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/v1/organizations"), nil)
+			c.Assert(err, qt.IsNil)
+			c.Assert(gotAuth, qt.Equals, tt.wantAuth)
+		})
+	}
+}
+
+func TestNewClientFromEnvironment_WithHTTPClient(t *testing.T) {
+	c := qt.New(t)
+
+	var gotAuth string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	setupEnvironment(t, map[string]string{
+		EnvServiceTokenID: "env-id",
+		EnvServiceToken:   "env-token",
+		EnvAPIURL:         "https://api.example.com",
+		EnvOrganization:   "env-org",
+	}, nil)
+
+	client, err := NewClientFromEnvironment(
+		WithHTTPClient(&http.Client{}),
+		WithBaseURL(ts.URL),
+		withDefaultOrganization("my-org"),
+	)
+	c.Assert(err, qt.IsNil)
+	c.Assert(client.DefaultOrganization(), qt.Equals, "my-org")
+
+	err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/v1/organizations"), nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(gotAuth, qt.Equals, "env-id:env-token")
+}
+
+func TestNewClientFromEnvironment_Errors(t *testing.T) {
+	c := qt.New(t)
+
+	setupEnvironment(t, nil, nil)
+	_, err := NewClientFromEnvironment()
+	c.Assert(err, qt.ErrorMatches, "no PlanetScale credentials found: .*")
+
+	setupEnvironment(t, map[string]string{EnvServiceTokenID: "id"}, nil)
+	_, err = NewClientFromEnvironment()
+	c.Assert(err, qt.ErrorMatches, "both PLANETSCALE_SERVICE_TOKEN_ID and PLANETSCALE_SERVICE_TOKEN must be set")
+}
@@ -0,0 +1,12 @@
+package planetscale
+
+// withIdempotencyKey sets the Idempotency-Key header of a create request to
+// key, so the API can recognize retries of a request it already accepted.
+// The header also makes the request retryable under a RetryPolicy, so it is
+// left out when key is empty: not every endpoint honors it, and retrying a
+// create the server doesn't deduplicate could create the resource twice.
+func withIdempotencyKey(key string) RequestOption {
+	return func(opts *requestOptions) {
+		opts.idempotencyKey = key
+	}
+}
@@ -0,0 +1,48 @@
+package planetscale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestCreate_IdempotencyKey(t *testing.T) {
+	c := qt.New(t)
+
+	var keys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "branch-1", "name": "feature"}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithRetryPolicy(RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+	c.Assert(err, qt.IsNil)
+
+	// Without a key, the create call is sent once.
+	createReq := &CreateDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Name: "feature", ParentBranch: "main"}
+	_, err = client.DatabaseBranches.Create(context.Background(), createReq)
+	c.Assert(err, qt.ErrorIs, &Error{Code: ErrUnavailable})
+	c.Assert(keys, qt.DeepEquals, []string{""})
+
+	// With a key, it is retried with the same key.
+	keys = nil
+	createReq.IdempotencyKey = "my-key"
+	_, err = client.DatabaseBranches.Create(context.Background(), createReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(keys, qt.DeepEquals, []string{"my-key", "my-key"})
+
+	// The key only applies to the call it was given for.
+	_, err = client.Passwords.Create(context.Background(), &DatabaseBranchPasswordRequest{Organization: "my-org", Database: "my-db", Branch: "feature"})
+	c.Assert(err, qt.Not(qt.IsNil))
+	c.Assert(keys[2], qt.Equals, "")
+}
@@ -245,7 +245,7 @@ type dataImportsService struct {
 func (d *dataImportsService) TestDataImportSource(ctx context.Context, request *TestDataImportSourceRequest) (*TestDataImportSourceResponse, error) {
 	request.Connection.SSLMode = request.Connection.SSLVerificationMode.String()
 	pathStr := path.Join("/v1/organizations", request.Organization, "data-imports/test-connection")
-	req, err := d.client.newRequest(http.MethodPost, pathStr, request)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, request, withOperation("DataImports", "TestDataImportSource"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -266,7 +266,7 @@ func (d *dataImportsService) TestDataImportSource(ctx context.Context, request *
 func (d *dataImportsService) StartDataImport(ctx context.Context, request *StartDataImportRequest) (*DataImport, error) {
 	request.Connection.SSLMode = request.Connection.SSLVerificationMode.String()
 	pathStr := path.Join("/v1/organizations", request.Organization, "data-imports/new")
-	req, err := d.client.newRequest(http.MethodPost, pathStr, request)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, request, withOperation("DataImports", "StartDataImport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -281,7 +281,7 @@ func (d *dataImportsService) StartDataImport(ctx context.Context, request *Start
 
 func (d *dataImportsService) GetDataImportStatus(ctx context.Context, getReq *GetImportStatusRequest) (*DataImport, error) {
 	pathStr := dataImportAPIPath(getReq.Organization, getReq.Database)
-	req, err := d.client.newRequest(http.MethodGet, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodGet, pathStr, nil, withOperation("DataImports", "GetDataImportStatus"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get database: %w", err)
 	}
@@ -298,7 +298,7 @@ func (d *dataImportsService) GetDataImportStatus(ctx context.Context, getReq *Ge
 
 func (d *dataImportsService) CancelDataImport(ctx context.Context, cancelReq *CancelDataImportRequest) error {
 	pathStr := path.Join(dataImportAPIPath(cancelReq.Organization, cancelReq.Database), "cancel")
-	req, err := d.client.newRequest(http.MethodPost, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, nil, withOperation("DataImports", "CancelDataImport"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -312,7 +312,7 @@ func (d *dataImportsService) CancelDataImport(ctx context.Context, cancelReq *Ca
 
 func (d *dataImportsService) MakePlanetScalePrimary(ctx context.Context, request *MakePlanetScalePrimaryRequest) (*DataImport, error) {
 	pathStr := path.Join(dataImportAPIPath(request.Organization, request.Database), "begin-switch-traffic")
-	req, err := d.client.newRequest(http.MethodPost, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, nil, withOperation("DataImports", "MakePlanetScalePrimary"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -327,7 +327,7 @@ func (d *dataImportsService) MakePlanetScalePrimary(ctx context.Context, request
 
 func (d *dataImportsService) MakePlanetScaleReplica(ctx context.Context, request *MakePlanetScaleReplicaRequest) (*DataImport, error) {
 	pathStr := path.Join(dataImportAPIPath(request.Organization, request.Database), "begin-reverse-traffic")
-	req, err := d.client.newRequest(http.MethodPost, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, nil, withOperation("DataImports", "MakePlanetScaleReplica"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -342,7 +342,7 @@ func (d *dataImportsService) MakePlanetScaleReplica(ctx context.Context, request
 
 func (d *dataImportsService) DetachExternalDatabase(ctx context.Context, request *DetachExternalDatabaseRequest) (*DataImport, error) {
 	pathStr := path.Join(dataImportAPIPath(request.Organization, request.Database), "detach-external-database")
-	req, err := d.client.newRequest(http.MethodPost, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, nil, withOperation("DataImports", "DetachExternalDatabase"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
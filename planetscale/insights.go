@@ -179,7 +179,7 @@ type anomaliesResponse struct {
 func (s *queryInsightsService) ListQueries(ctx context.Context, request *ListQueryInsightsRequest, opts ...ListOption) ([]*QueryInsight, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, insightsAPIPath(request.Organization, request.Database, request.Branch), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, insightsAPIPath(request.Organization, request.Database, request.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "ListQueries"))
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +195,7 @@ func (s *queryInsightsService) ListQueries(ctx context.Context, request *ListQue
 func (s *queryInsightsService) ListErrors(ctx context.Context, request *ListQueryInsightsErrorsRequest, opts ...ListOption) ([]*QueryInsightError, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, path.Join(insightsAPIPath(request.Organization, request.Database, request.Branch), "errors"), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, path.Join(insightsAPIPath(request.Organization, request.Database, request.Branch), "errors"), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "ListErrors"))
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +211,7 @@ func (s *queryInsightsService) ListErrors(ctx context.Context, request *ListQuer
 func (s *queryInsightsService) ListAnomalies(ctx context.Context, request *ListAnomaliesRequest, opts ...ListOption) ([]*Anomaly, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, path.Join(insightsAPIPath(request.Organization, request.Database, request.Branch), "anomalies"), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, path.Join(insightsAPIPath(request.Organization, request.Database, request.Branch), "anomalies"), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "ListAnomalies"))
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +231,7 @@ type querySamplesResponse struct {
 func (s *queryInsightsService) ListQuerySamples(ctx context.Context, request *ListQuerySamplesRequest, opts ...ListOption) ([]*QuerySample, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, insightsFingerprintAPIPath(request.Organization, request.Database, request.Branch, request.Fingerprint), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, insightsFingerprintAPIPath(request.Organization, request.Database, request.Branch, request.Fingerprint), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "ListQuerySamples"))
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +114,7 @@ func WithKeyspace(keyspace string) ListOption {
 func (s *queryInsightsService) ListTags(ctx context.Context, request *ListQueryTagsRequest, opts ...ListOption) ([]*QueryTag, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, insightsTagsAPIPath(request.Organization, request.Database, request.Branch), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, insightsTagsAPIPath(request.Organization, request.Database, request.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "ListTags"))
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +130,7 @@ func (s *queryInsightsService) ListTags(ctx context.Context, request *ListQueryT
 func (s *queryInsightsService) GetTag(ctx context.Context, request *GetQueryTagRequest, opts ...ListOption) (*QueryTag, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, insightsTagAPIPath(request.Organization, request.Database, request.Branch, request.Tag), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, insightsTagAPIPath(request.Organization, request.Database, request.Branch, request.Tag), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "GetTag"))
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +147,7 @@ func (s *queryInsightsService) ListTagSummaries(ctx context.Context, request *Li
 	opts = append([]ListOption{WithTags(request.Tags)}, opts...)
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, insightsTagSummariesAPIPath(request.Organization, request.Database, request.Branch), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, insightsTagSummariesAPIPath(request.Organization, request.Database, request.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("QueryInsights", "ListTagSummaries"))
 	if err != nil {
 		return nil, err
 	}
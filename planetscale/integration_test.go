@@ -39,7 +39,7 @@ func TestIntegration_Databases_List(t *testing.T) {
 
 	dbName := "planetscale-go-test-db"
 
-	_, err = client.Databases.Create(ctx, &CreateDatabaseRequest{
+	db, err := client.Databases.Create(ctx, &CreateDatabaseRequest{
 		Organization: org,
 		Name:         dbName,
 	})
@@ -47,8 +47,16 @@ func TestIntegration_Databases_List(t *testing.T) {
 		t.Fatalf("create database failed: %s", err)
 	}
 
-	// poor mans polling, remove once we have an API to poll the status of the DB
-	time.Sleep(time.Second * 2)
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+	_, err = client.DatabaseBranches.WaitForBranchReady(waitCtx, &WaitForBranchReadyRequest{
+		Organization: org,
+		Database:     dbName,
+		Branch:       db.DefaultBranch,
+	})
+	if err != nil {
+		t.Fatalf("waiting for branch %s failed: %s", db.DefaultBranch, err)
+	}
 
 	dbs, err := client.Databases.List(ctx, &ListDatabasesRequest{
 		Organization: org,
@@ -65,7 +73,7 @@ func TestIntegration_Databases_List(t *testing.T) {
 		fmt.Printf("Notes: %q\n", db.Notes)
 	}
 
-	err = client.Databases.Delete(ctx, &DeleteDatabaseRequest{
+	_, err = client.Databases.Delete(ctx, &DeleteDatabaseRequest{
 		Organization: org,
 		Database:     dbName,
 	})
@@ -105,8 +113,8 @@ func TestIntegration_AuditLogs_List(t *testing.T) {
 		t.Fatalf("get audit logs failed: %s", err)
 	}
 
-	for _, l := range auditLogs {
+	for _, l := range auditLogs.Data {
 		fmt.Printf("l. = %+v\n", l.AuditAction)
 	}
-	fmt.Printf("len(auditLogs) = %+v\n", len(auditLogs))
+	fmt.Printf("len(auditLogs) = %+v\n", len(auditLogs.Data))
 }
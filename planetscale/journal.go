@@ -0,0 +1,138 @@
+package planetscale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JournalEntry records a call that changes resources, whether it succeeded
+// or failed.
+type JournalEntry struct {
+	// Time is when the call started.
+	Time time.Time `json:"time"`
+
+	// Service and Method name the client method that made the call, e.g.
+	// "DatabaseBranches" and "Create". They are empty for requests made
+	// with Client.Do.
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+
+	HTTPMethod string `json:"http_method"`
+	Path       string `json:"path"`
+
+	// Organization, Database and Branch are taken from the request path.
+	Organization string `json:"organization,omitempty"`
+	Database     string `json:"database,omitempty"`
+	Branch       string `json:"branch,omitempty"`
+
+	// Body is the JSON request body with secret fields redacted.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// ResultID is the id of the resource returned by a successful call, if
+	// any.
+	ResultID string `json:"result_id,omitempty"`
+
+	// Error is the error returned by a failed call.
+	Error string `json:"error,omitempty"`
+}
+
+// Journal stores the entries written by a client created with WithJournal.
+type Journal interface {
+	Record(entry *JournalEntry) error
+}
+
+// WithJournal records every call other than a GET in journal once it
+// returns, giving an exact local trail of what the client changed. Errors
+// from the journal don't fail the call; they are logged if the client has a
+// logger.
+func WithJournal(journal Journal) ClientOption {
+	return func(c *Client) error {
+		if journal == nil {
+			return errors.New("journal must not be nil")
+		}
+		c.journal = journal
+		return nil
+	}
+}
+
+// NewJSONLinesJournal returns a Journal that writes each entry to w as a
+// line of JSON. It is safe for concurrent use.
+func NewJSONLinesJournal(w io.Writer) Journal {
+	return &jsonLinesJournal{enc: json.NewEncoder(w)}
+}
+
+type jsonLinesJournal struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (j *jsonLinesJournal) Record(entry *JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.enc.Encode(entry)
+}
+
+// record writes the outcome of req to the client's journal. v is the value
+// the response was decoded into.
+func (c *Client) record(ctx context.Context, start time.Time, req *http.Request, v interface{}, callErr error) {
+	op := operationFromRequest(req)
+	entry := &JournalEntry{
+		Time:         start,
+		Service:      op.Service,
+		Method:       op.Method,
+		HTTPMethod:   req.Method,
+		Path:         req.URL.Path,
+		Organization: op.PathParams["organization"],
+		Database:     op.PathParams["database"],
+		Branch:       op.PathParams["branch"],
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			if out, ok := redactJSON(data); ok {
+				entry.Body = out
+			}
+		}
+	}
+
+	if callErr != nil {
+		entry.Error = callErr.Error()
+	} else {
+		entry.ResultID = resultID(v)
+	}
+
+	if err := c.journal.Record(entry); err != nil && c.logger != nil {
+		c.logger.LogAttrs(ctx, slog.LevelError, "planetscale journal failed",
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// resultID returns the id field of the decoded response v, or an empty
+// string if it has none.
+func resultID(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return ""
+	}
+	return result.ID
+}
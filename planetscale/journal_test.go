@@ -0,0 +1,73 @@
+package planetscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestWithJournal(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "not_found", "message": "Not Found"}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id": "branch-1", "name": "feature"}`))
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	var buf bytes.Buffer
+	client, err := NewClient(WithBaseURL(ts.URL), WithJournal(NewJSONLinesJournal(&buf)))
+	c.Assert(err, qt.IsNil)
+	ctx := context.Background()
+
+	_, err = client.DatabaseBranches.Create(ctx, &CreateDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Name: "feature", ParentBranch: "main"})
+	c.Assert(err, qt.IsNil)
+	_, err = client.DatabaseBranches.Get(ctx, &GetDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "feature"})
+	c.Assert(err, qt.IsNil)
+	err = client.DatabaseBranches.Delete(ctx, &DeleteDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "other"})
+	c.Assert(err, qt.IsNotNil)
+	err = client.Raw(ctx, http.MethodPost, "v1/organizations/my-org/databases/my-db/branches/feature/roles", map[string]string{"name": "app", "password": "hunter2"}, nil)
+	c.Assert(err, qt.IsNil)
+
+	var entries []JournalEntry
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry JournalEntry
+		c.Assert(dec.Decode(&entry), qt.IsNil)
+		c.Assert(entry.Time.IsZero(), qt.IsFalse)
+		entries = append(entries, entry)
+	}
+	c.Assert(entries, qt.HasLen, 3)
+
+	created := entries[0]
+	c.Assert(created.Service, qt.Equals, "DatabaseBranches")
+	c.Assert(created.Method, qt.Equals, "Create")
+	c.Assert(created.HTTPMethod, qt.Equals, http.MethodPost)
+	c.Assert(created.Organization, qt.Equals, "my-org")
+	c.Assert(created.Database, qt.Equals, "my-db")
+	c.Assert(created.ResultID, qt.Equals, "branch-1")
+	c.Assert(created.Error, qt.Equals, "")
+	c.Assert(string(created.Body), qt.JSONEquals, map[string]interface{}{"name": "feature", "parent_branch": "main"})
+
+	deleted := entries[1]
+	c.Assert(deleted.Method, qt.Equals, "Delete")
+	c.Assert(deleted.Branch, qt.Equals, "other")
+	c.Assert(deleted.ResultID, qt.Equals, "")
+	c.Assert(deleted.Error, qt.Equals, "Not Found")
+
+	raw := entries[2]
+	c.Assert(raw.Service, qt.Equals, "")
+	c.Assert(raw.Branch, qt.Equals, "feature")
+	c.Assert(string(raw.Body), qt.JSONEquals, map[string]interface{}{"name": "app", "password": "REDACTED"})
+}
@@ -2,7 +2,9 @@ package planetscale
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"path"
@@ -25,6 +27,22 @@ type Keyspace struct {
 	VReplicationFlags                *VReplicationFlags                `json:"vreplication_flags"`
 	ReplicationDurabilityConstraints *ReplicationDurabilityConstraints `json:"replication_durability_constraints"`
 	ReadOnlyRegions                  []*ReadOnlyRegionKeyspace         `json:"read_only_regions"`
+
+	// Extra holds response fields not declared above, such as ones the API
+	// added after this release. It rules out comparing keyspaces with ==,
+	// so compare their IDs.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the keyspace, keeping undeclared fields in Extra.
+func (k *Keyspace) UnmarshalJSON(data []byte) error {
+	type keyspace Keyspace
+	extra, err := unmarshalWithExtra(data, (*keyspace)(k))
+	if err != nil {
+		return err
+	}
+	k.Extra = extra
+	return nil
 }
 
 type ReadOnlyRegionKeyspace struct {
@@ -60,6 +78,10 @@ type CreateKeyspaceRequest struct {
 	ClusterSize   string `json:"cluster_size"`
 	ExtraReplicas int    `json:"extra_replicas"`
 	Shards        int    `json:"shards"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 type GetKeyspaceRequest struct {
@@ -101,6 +123,7 @@ type UpdateKeyspaceVSchemaRequest struct {
 }
 
 type keyspacesResponse struct {
+	Pagination
 	Keyspaces []*Keyspace `json:"data"`
 }
 
@@ -114,9 +137,9 @@ type ResizeKeyspaceRequest struct {
 }
 
 type KeyspaceResizeRequest struct {
-	ID    string `json:"id"`
-	State string `json:"state"`
-	Actor *Actor `json:"actor"`
+	ID    string      `json:"id"`
+	State ResizeState `json:"state"`
+	Actor *Actor      `json:"actor"`
 
 	ClusterSize         string `json:"cluster_name"`
 	PreviousClusterSize string `json:"previous_cluster_name"`
@@ -189,7 +212,8 @@ type VReplicationFlags struct {
 // KeyspacesService is an interface for interacting with the keyspace endpoints of the PlanetScale API
 type KeyspacesService interface {
 	Create(context.Context, *CreateKeyspaceRequest) (*Keyspace, error)
-	List(context.Context, *ListKeyspacesRequest) ([]*Keyspace, error)
+	List(context.Context, *ListKeyspacesRequest, ...ListOption) ([]*Keyspace, error)
+	All(context.Context, *ListKeyspacesRequest, ...ListOption) iter.Seq2[*Keyspace, error]
 	Get(context.Context, *GetKeyspaceRequest) (*Keyspace, error)
 	Delete(context.Context, *DeleteKeyspaceRequest) error
 	UpdateReadOnlyRegions(context.Context, *UpdateReadOnlyRegionsRequest) ([]*ReadOnlyRegionKeyspace, error)
@@ -198,6 +222,7 @@ type KeyspacesService interface {
 	Resize(context.Context, *ResizeKeyspaceRequest) (*KeyspaceResizeRequest, error)
 	CancelResize(context.Context, *CancelKeyspaceResizeRequest) error
 	ResizeStatus(context.Context, *KeyspaceResizeStatusRequest) (*KeyspaceResizeRequest, error)
+	WaitForResize(context.Context, *WaitForKeyspaceResizeRequest, ...WaitOption) (*KeyspaceResizeRequest, error)
 	RolloutStatus(context.Context, *KeyspaceRolloutStatusRequest) (*KeyspaceRollout, error)
 	UpdateSettings(context.Context, *UpdateKeyspaceSettingsRequest) (*Keyspace, error)
 }
@@ -213,8 +238,15 @@ func NewKeyspacesService(client *Client) *keyspacesService {
 }
 
 // List returns a list of keyspaces for a branch
-func (s *keyspacesService) List(ctx context.Context, listReq *ListKeyspacesRequest) ([]*Keyspace, error) {
-	req, err := s.client.newRequest(http.MethodGet, keyspacesAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil)
+func (s *keyspacesService) List(ctx context.Context, listReq *ListKeyspacesRequest, opts ...ListOption) ([]*Keyspace, error) {
+	listOpts := defaultListOptions()
+	for _, opt := range opts {
+		if err := opt(listOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, keyspacesAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("Keyspaces", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -223,10 +255,19 @@ func (s *keyspacesService) List(ctx context.Context, listReq *ListKeyspacesReque
 	if err := s.client.do(ctx, req, keyspaces); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(keyspaces.Pagination)
 
 	return keyspaces.Keyspaces, nil
 }
 
+// All returns an iterator over all keyspaces of a branch, fetching pages as
+// needed.
+func (s *keyspacesService) All(ctx context.Context, listReq *ListKeyspacesRequest, opts ...ListOption) iter.Seq2[*Keyspace, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*Keyspace, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 // Get returns a keyspace for a branch
 func (s *keyspacesService) Get(ctx context.Context, getReq *GetKeyspaceRequest) (*Keyspace, error) {
 	query := url.Values{}
@@ -234,7 +275,7 @@ func (s *keyspacesService) Get(ctx context.Context, getReq *GetKeyspaceRequest)
 		query.Set("full", "true")
 	}
 
-	req, err := s.client.newRequest(http.MethodGet, keyspaceAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Keyspace), nil, WithQueryParams(query))
+	req, err := s.client.newRequest(http.MethodGet, keyspaceAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Keyspace), nil, WithQueryParams(query), withOperation("Keyspaces", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -250,7 +291,7 @@ func (s *keyspacesService) Get(ctx context.Context, getReq *GetKeyspaceRequest)
 // UpdateReadOnlyRegions configures a keyspace's read-only regions.
 func (s *keyspacesService) UpdateReadOnlyRegions(ctx context.Context, updateReq *UpdateReadOnlyRegionsRequest) ([]*ReadOnlyRegionKeyspace, error) {
 	pathStr := path.Join(keyspaceAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.Keyspace), "read-only-regions")
-	req, err := s.client.newRequest(http.MethodPut, pathStr, updateReq)
+	req, err := s.client.newRequest(http.MethodPut, pathStr, updateReq, withOperation("Keyspaces", "UpdateReadOnlyRegions"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -265,7 +306,7 @@ func (s *keyspacesService) UpdateReadOnlyRegions(ctx context.Context, updateReq
 
 // Create creates a keyspace for a branch
 func (s *keyspacesService) Create(ctx context.Context, createReq *CreateKeyspaceRequest) (*Keyspace, error) {
-	req, err := s.client.newRequest(http.MethodPost, keyspacesAPIPath(createReq.Organization, createReq.Database, createReq.Branch), createReq)
+	req, err := s.client.newRequest(http.MethodPost, keyspacesAPIPath(createReq.Organization, createReq.Database, createReq.Branch), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("Keyspaces", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -280,7 +321,7 @@ func (s *keyspacesService) Create(ctx context.Context, createReq *CreateKeyspace
 
 // Delete deletes a keyspace from a branch.
 func (s *keyspacesService) Delete(ctx context.Context, deleteReq *DeleteKeyspaceRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, keyspaceAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.Keyspace), nil)
+	req, err := s.client.newRequest(http.MethodDelete, keyspaceAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.Keyspace), nil, withOperation("Keyspaces", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -291,7 +332,7 @@ func (s *keyspacesService) Delete(ctx context.Context, deleteReq *DeleteKeyspace
 // VSchema returns the VSchema for a keyspace in a branch
 func (s *keyspacesService) VSchema(ctx context.Context, getReq *GetKeyspaceVSchemaRequest) (*VSchema, error) {
 	pathStr := path.Join(keyspaceAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Keyspace), "vschema")
-	req, err := s.client.newRequest(http.MethodGet, pathStr, nil)
+	req, err := s.client.newRequest(http.MethodGet, pathStr, nil, withOperation("Keyspaces", "VSchema"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -306,7 +347,7 @@ func (s *keyspacesService) VSchema(ctx context.Context, getReq *GetKeyspaceVSche
 
 func (s *keyspacesService) UpdateVSchema(ctx context.Context, updateReq *UpdateKeyspaceVSchemaRequest) (*VSchema, error) {
 	pathStr := path.Join(keyspaceAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.Keyspace), "vschema")
-	req, err := s.client.newRequest(http.MethodPatch, pathStr, updateReq)
+	req, err := s.client.newRequest(http.MethodPatch, pathStr, updateReq, withOperation("Keyspaces", "UpdateVSchema"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -321,7 +362,7 @@ func (s *keyspacesService) UpdateVSchema(ctx context.Context, updateReq *UpdateK
 
 // Resize starts or queues a resize of a branch's keyspace.
 func (s *keyspacesService) Resize(ctx context.Context, resizeReq *ResizeKeyspaceRequest) (*KeyspaceResizeRequest, error) {
-	req, err := s.client.newRequest(http.MethodPut, keyspaceResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch, resizeReq.Keyspace), resizeReq)
+	req, err := s.client.newRequest(http.MethodPut, keyspaceResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch, resizeReq.Keyspace), resizeReq, withOperation("Keyspaces", "Resize"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -336,7 +377,7 @@ func (s *keyspacesService) Resize(ctx context.Context, resizeReq *ResizeKeyspace
 
 // CancelResize cancels a queued resize of a branch's keyspace.
 func (s *keyspacesService) CancelResize(ctx context.Context, cancelReq *CancelKeyspaceResizeRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, keyspaceResizesAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch, cancelReq.Keyspace), nil)
+	req, err := s.client.newRequest(http.MethodDelete, keyspaceResizesAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch, cancelReq.Keyspace), nil, withOperation("Keyspaces", "CancelResize"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -361,7 +402,7 @@ type keyspaceResizesResponse struct {
 }
 
 func (s *keyspacesService) ResizeStatus(ctx context.Context, resizeReq *KeyspaceResizeStatusRequest) (*KeyspaceResizeRequest, error) {
-	req, err := s.client.newRequest(http.MethodGet, keyspaceResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch, resizeReq.Keyspace), nil)
+	req, err := s.client.newRequest(http.MethodGet, keyspaceResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch, resizeReq.Keyspace), nil, withOperation("Keyspaces", "ResizeStatus"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -387,7 +428,7 @@ func keyspaceRolloutStatusAPIPath(org, db, branch, keyspace string) string {
 }
 
 func (s *keyspacesService) RolloutStatus(ctx context.Context, rolloutReq *KeyspaceRolloutStatusRequest) (*KeyspaceRollout, error) {
-	req, err := s.client.newRequest(http.MethodGet, keyspaceRolloutStatusAPIPath(rolloutReq.Organization, rolloutReq.Database, rolloutReq.Branch, rolloutReq.Keyspace), nil)
+	req, err := s.client.newRequest(http.MethodGet, keyspaceRolloutStatusAPIPath(rolloutReq.Organization, rolloutReq.Database, rolloutReq.Branch, rolloutReq.Keyspace), nil, withOperation("Keyspaces", "RolloutStatus"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -401,7 +442,7 @@ func (s *keyspacesService) RolloutStatus(ctx context.Context, rolloutReq *Keyspa
 }
 
 func (s *keyspacesService) UpdateSettings(ctx context.Context, updateReq *UpdateKeyspaceSettingsRequest) (*Keyspace, error) {
-	req, err := s.client.newRequest(http.MethodPatch, keyspaceAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.Keyspace), updateReq)
+	req, err := s.client.newRequest(http.MethodPatch, keyspaceAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.Keyspace), updateReq, withOperation("Keyspaces", "UpdateSettings"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
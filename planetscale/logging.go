@@ -0,0 +1,117 @@
+package planetscale
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxLoggedBodySize caps the size of request and response bodies included
+// in debug logs.
+const maxLoggedBodySize = 4096
+
+// WithLogger logs every HTTP request sent by the client, including retries.
+// Each record holds the operation, HTTP method, path, status, latency and
+// request ID. Requests that fail or return an error status are logged at
+// warn level, others at info level. When the logger is enabled for debug
+// level, records also hold the request headers and the request and response
+// bodies. Credentials, such as the Authorization header, password plain
+// texts, webhook secrets and service token values, are redacted.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+
+		c.logger = logger
+		return nil
+	}
+}
+
+// sendLogged sends req and logs the exchange.
+func (c *Client) sendLogged(ctx context.Context, req *http.Request) (*http.Response, error) {
+	op := operationFromRequest(req)
+	debug := c.logger.Enabled(ctx, slog.LevelDebug)
+
+	var reqBody []byte
+	if debug && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	start := time.Now()
+	res, err := c.client.Do(req.WithContext(ctx))
+	latency := time.Since(start)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	}
+	if op.Service != "" {
+		attrs = append(attrs, slog.String("operation", op.Service+"."+op.Method))
+	}
+	attrs = append(attrs, slog.Duration("latency", latency))
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		c.logger.LogAttrs(ctx, slog.LevelWarn, "planetscale API request failed", attrs...)
+		return nil, err
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", res.StatusCode),
+		slog.String("request_id", res.Header.Get(requestIDHeader)),
+	)
+
+	if debug {
+		resBody, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+		attrs = append(attrs, slog.Any("request_headers", redactHeader(req.Header)))
+		if len(reqBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", loggableBody(reqBody)))
+		}
+		if len(resBody) > 0 {
+			attrs = append(attrs, slog.String("response_body", loggableBody(resBody)))
+		}
+	}
+
+	level := slog.LevelInfo
+	if res.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	c.logger.LogAttrs(ctx, level, "planetscale API request", attrs...)
+
+	return res, nil
+}
+
+// loggableBody redacts and truncates a body for logging. Bodies that are
+// not JSON can't be redacted and are replaced by their size.
+func loggableBody(body []byte) string {
+	out, ok := redactJSON(body)
+	if !ok {
+		return "(non-JSON body, " + bodySize(len(body)) + ")"
+	}
+	if len(out) > maxLoggedBodySize {
+		return string(out[:maxLoggedBodySize]) + "..."
+	}
+	return string(out)
+}
+
+func bodySize(n int) string {
+	if n == 1 {
+		return "1 byte"
+	}
+	return strconv.Itoa(n) + " bytes"
+}
@@ -0,0 +1,98 @@
+package planetscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestWithLogger(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.Header.Get("Authorization"), qt.Equals, "token-id:secret-service-token")
+		w.Header().Set("X-Request-Id", "req-42")
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte(`{"id": "pw-1", "name": "my-password", "plain_text": "pscale_pw_secret"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := NewClient(
+		WithBaseURL(ts.URL),
+		WithServiceToken("token-id", "secret-service-token"),
+		WithRequestHeaders(map[string]string{"Cookie": "session=secret-cookie"}),
+		WithLogger(logger),
+	)
+	c.Assert(err, qt.IsNil)
+
+	password, err := client.Passwords.Create(context.Background(), &DatabaseBranchPasswordRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Name:         "my-password",
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(password.PlainText, qt.Equals, "pscale_pw_secret")
+
+	out := buf.String()
+	c.Assert(strings.Contains(out, "pscale_pw_secret"), qt.IsFalse)
+	c.Assert(strings.Contains(out, "secret-service-token"), qt.IsFalse)
+	c.Assert(strings.Contains(out, "secret-cookie"), qt.IsFalse)
+
+	var record map[string]interface{}
+	c.Assert(json.Unmarshal(buf.Bytes(), &record), qt.IsNil)
+	c.Assert(record["level"], qt.Equals, "INFO")
+	c.Assert(record["method"], qt.Equals, http.MethodPost)
+	c.Assert(record["path"], qt.Equals, "/v1/organizations/my-org/databases/my-db/branches/main/passwords")
+	c.Assert(record["operation"], qt.Equals, "Passwords.Create")
+	c.Assert(record["status"], qt.Equals, float64(http.StatusCreated))
+	c.Assert(record["request_id"], qt.Equals, "req-42")
+	c.Assert(record["latency"], qt.Not(qt.IsNil))
+	c.Assert(record["response_body"], qt.Equals, `{"id":"pw-1","name":"my-password","plain_text":"REDACTED"}`)
+}
+
+func TestWithLogger_InfoLevelOmitsBodies(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"code": "not_found", "message": "Not Found"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	var buf bytes.Buffer
+	client, err := NewClient(WithBaseURL(ts.URL), WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Databases.Get(context.Background(), &GetDatabaseRequest{Organization: "my-org", Database: "missing"})
+	c.Assert(err, qt.ErrorIs, &Error{Code: ErrNotFound})
+
+	var record map[string]interface{}
+	c.Assert(json.Unmarshal(buf.Bytes(), &record), qt.IsNil)
+	c.Assert(record["level"], qt.Equals, "WARN")
+	c.Assert(record["status"], qt.Equals, float64(http.StatusNotFound))
+	c.Assert(record["response_body"], qt.IsNil)
+}
+
+func TestRedactJSON(t *testing.T) {
+	c := qt.New(t)
+
+	out, ok := redactJSON([]byte(`{"data": [{"name": "role", "password": "pw"}, {"webhook": {"secret": "s", "url": "u"}}], "token": "", "ssl_key": null}`))
+	c.Assert(ok, qt.IsTrue)
+	c.Assert(string(out), qt.Equals, `{"data":[{"name":"role","password":"REDACTED"},{"webhook":{"secret":"REDACTED","url":"u"}}],"ssl_key":null,"token":""}`)
+
+	_, ok = redactJSON([]byte(`not-json`))
+	c.Assert(ok, qt.IsFalse)
+}
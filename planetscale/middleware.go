@@ -0,0 +1,136 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Operation describes a single API call made by a service method.
+type Operation struct {
+	// Service is the name of the Client field of the service that made the
+	// call, e.g. "DatabaseBranches". Empty when the call was not made by a
+	// service method.
+	Service string
+
+	// Method is the name of the service method that made the call, e.g.
+	// "Create".
+	Method string
+
+	// HTTPMethod is the HTTP method of the request.
+	HTTPMethod string
+
+	// Path is the URL path of the request.
+	Path string
+
+	// PathParams maps each collection of the path to the identifier
+	// following it, e.g. {"organization": "acme", "database": "db"} for
+	// /v1/organizations/acme/databases/db.
+	PathParams map[string]string
+
+	// Body is the value the request body was encoded from. Nil for requests
+	// without a body.
+	Body interface{}
+}
+
+// Handler performs the API call described by op and decodes its response
+// into v, which may be nil when the caller doesn't need the response.
+type Handler func(ctx context.Context, op *Operation, v interface{}) error
+
+// Middleware wraps a Handler. It may inspect the operation before calling
+// next, inspect the decoded response or error afterwards, or return an error
+// without calling next to refuse the call. Changing op doesn't change the
+// request that is sent.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware wraps every API call made by the client's services with the
+// given middleware. The first middleware is the outermost one. Retries and
+// rate limiting happen inside the chain, so each middleware sees a call once.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, mw := range middleware {
+			if mw == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+
+		c.middleware = append(c.middleware, middleware...)
+		return nil
+	}
+}
+
+type operationKey struct{}
+
+// withOperation names the service method making a request, e.g.
+// withOperation("DatabaseBranches", "Create"). service is the name of the
+// service's Client field.
+func withOperation(service, method string) RequestOption {
+	return func(opts *requestOptions) {
+		opts.service = service
+		opts.method = method
+	}
+}
+
+// observesOperations reports whether anything configured on c looks at the
+// operation of a request. Only then does newRequest attach one.
+func (c *Client) observesOperations() bool {
+	return len(c.middleware) > 0 || c.journal != nil || c.dryRun != nil || c.readOnly || c.logger != nil
+}
+
+// newOperation describes a request built by newRequest for the given service
+// method.
+func newOperation(req *http.Request, service, method string, body interface{}) *Operation {
+	return &Operation{
+		Service:    service,
+		Method:     method,
+		HTTPMethod: req.Method,
+		Path:       req.URL.Path,
+		PathParams: pathParams(req.URL.Path),
+		Body:       body,
+	}
+}
+
+// operationFromRequest returns the operation attached to req by newRequest.
+func operationFromRequest(req *http.Request) *Operation {
+	if op, ok := req.Context().Value(operationKey{}).(*Operation); ok {
+		return op
+	}
+	return newOperation(req, "", "", nil)
+}
+
+// pathParams maps every plural segment of an API path to the segment
+// following it, e.g. "deploy-requests/12" becomes {"deploy_request": "12"}.
+func pathParams(p string) map[string]string {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	params := make(map[string]string)
+	for i := 0; i+1 < len(segments); i++ {
+		collection := segments[i]
+		if len(collection) < 2 || !strings.HasSuffix(collection, "s") {
+			continue
+		}
+		params[singular(collection)] = segments[i+1]
+		i++
+	}
+	return params
+}
+
+func singular(collection string) string {
+	s := strings.ReplaceAll(collection, "-", "_")
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "ches"), strings.HasSuffix(s, "shes"), strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	default:
+		return strings.TrimSuffix(s, "s")
+	}
+}
+
+// chain wraps h with the client's middleware.
+func (c *Client) chain(h Handler) Handler {
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+	return h
+}
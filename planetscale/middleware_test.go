@@ -0,0 +1,169 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte(`{"id": "pw-1", "name": "my-password"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	var (
+		calls []string
+		seen  *Operation
+		resp  interface{}
+	)
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, op *Operation, v interface{}) error {
+				calls = append(calls, name+" before")
+				err := next(ctx, op, v)
+				calls = append(calls, name+" after")
+				seen, resp = op, v
+				return err
+			}
+		}
+	}
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithMiddleware(record("outer"), record("inner")))
+	c.Assert(err, qt.IsNil)
+
+	createReq := &DatabaseBranchPasswordRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Name:         "my-password",
+	}
+	password, err := client.Passwords.Create(context.Background(), createReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(password.PublicID, qt.Equals, "pw-1")
+
+	c.Assert(calls, qt.DeepEquals, []string{"outer before", "inner before", "inner after", "outer after"})
+	c.Assert(seen.Service, qt.Equals, "Passwords")
+	c.Assert(seen.Method, qt.Equals, "Create")
+	c.Assert(seen.HTTPMethod, qt.Equals, http.MethodPost)
+	c.Assert(seen.Path, qt.Equals, "/v1/organizations/my-org/databases/my-db/branches/main/passwords")
+	c.Assert(seen.PathParams, qt.DeepEquals, map[string]string{
+		"organization": "my-org",
+		"database":     "my-db",
+		"branch":       "main",
+	})
+	c.Assert(seen.Body, qt.Equals, createReq)
+
+	decoded, ok := resp.(**DatabaseBranchPassword)
+	c.Assert(ok, qt.IsTrue)
+	c.Assert((*decoded).Name, qt.Equals, "my-password")
+}
+
+func TestWithMiddleware_CanRefuseCalls(t *testing.T) {
+	c := qt.New(t)
+
+	hits := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	t.Cleanup(ts.Close)
+
+	errRefused := errors.New("refused by policy")
+	client, err := NewClient(WithBaseURL(ts.URL), WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation, v interface{}) error {
+			if op.Service == "Databases" && op.Method == "Delete" {
+				return errRefused
+			}
+			return next(ctx, op, v)
+		}
+	}))
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Databases.Delete(context.Background(), &DeleteDatabaseRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+	})
+	c.Assert(err, qt.ErrorIs, errRefused)
+	c.Assert(hits, qt.Equals, 0)
+}
+
+// wrappedDatabases stands in for a service a caller replaced after NewClient.
+type wrappedDatabases struct {
+	DatabasesService
+}
+
+func TestWithMiddleware_NamesWrappedServices(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"id": "db-1", "name": "my-db"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	var seen *Operation
+	client, err := NewClient(WithBaseURL(ts.URL), WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation, v interface{}) error {
+			seen = op
+			return next(ctx, op, v)
+		}
+	}))
+	c.Assert(err, qt.IsNil)
+	client.Databases = &wrappedDatabases{client.Databases}
+
+	_, err = client.Databases.Get(context.Background(), &GetDatabaseRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(seen.Service, qt.Equals, "Databases")
+	c.Assert(seen.Method, qt.Equals, "Get")
+}
+
+func TestNewRequest_NoOperationWithoutObservers(t *testing.T) {
+	c := qt.New(t)
+
+	client, err := NewClient()
+	c.Assert(err, qt.IsNil)
+
+	req, err := client.newRequest(http.MethodGet, "/v1/organizations", nil, withOperation("Organizations", "List"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(req.Context().Value(operationKey{}), qt.IsNil)
+}
+
+func TestWithMiddleware_RejectsNil(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := NewClient(WithMiddleware(nil))
+	c.Assert(err, qt.ErrorMatches, "middleware must not be nil")
+}
+
+func TestPathParams(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(pathParams("/v1/organizations/acme/databases/db/deploy-requests/12/deploy"), qt.DeepEquals, map[string]string{
+		"organization":   "acme",
+		"database":       "db",
+		"deploy_request": "12",
+	})
+	c.Assert(pathParams("/v1/organizations/acme/databases/db/branches/main/vtctld/operations/op-1"), qt.DeepEquals, map[string]string{
+		"organization": "acme",
+		"database":     "db",
+		"branch":       "main",
+		"operation":    "op-1",
+	})
+	c.Assert(pathParams("/v1/organizations/acme/databases/db/backup-policies/p1"), qt.DeepEquals, map[string]string{
+		"organization":  "acme",
+		"database":      "db",
+		"backup_policy": "p1",
+	})
+}
@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -20,7 +21,8 @@ type GetOrganizationRequest struct {
 // Organizations API endpoints.
 type OrganizationsService interface {
 	Get(context.Context, *GetOrganizationRequest) (*Organization, error)
-	List(context.Context) ([]*Organization, error)
+	List(context.Context, ...ListOption) ([]*Organization, error)
+	All(context.Context, ...ListOption) iter.Seq2[*Organization, error]
 	ListRegions(context.Context, *ListOrganizationRegionsRequest) ([]*Region, error)
 	ListClusterSKUs(context.Context, *ListOrganizationClusterSKUsRequest, ...ListOption) ([]*ClusterSKU, error)
 }
@@ -67,6 +69,7 @@ type Organization struct {
 }
 
 type organizationsResponse struct {
+	Pagination
 	Organizations []*Organization `json:"data"`
 }
 
@@ -84,7 +87,7 @@ func NewOrganizationsService(client *Client) *organizationsService {
 
 // Get fetches a single organization by name.
 func (o *organizationsService) Get(ctx context.Context, getReq *GetOrganizationRequest) (*Organization, error) {
-	req, err := o.client.newRequest(http.MethodGet, path.Join(organizationsAPIPath, getReq.Organization), nil)
+	req, err := o.client.newRequest(http.MethodGet, path.Join(organizationsAPIPath, getReq.Organization), nil, withOperation("Organizations", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get organization: %w", err)
 	}
@@ -97,9 +100,16 @@ func (o *organizationsService) Get(ctx context.Context, getReq *GetOrganizationR
 	return org, nil
 }
 
-// List returns all the organizations for a user.
-func (o *organizationsService) List(ctx context.Context) ([]*Organization, error) {
-	req, err := o.client.newRequest(http.MethodGet, organizationsAPIPath, nil)
+// List returns the organizations for a user, one page at a time.
+func (o *organizationsService) List(ctx context.Context, opts ...ListOption) ([]*Organization, error) {
+	listOpts := defaultListOptions()
+	for _, opt := range opts {
+		if err := opt(listOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := o.client.newRequest(http.MethodGet, organizationsAPIPath, nil, WithQueryParams(*listOpts.URLValues), withOperation("Organizations", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list organization: %w", err)
 	}
@@ -108,16 +118,25 @@ func (o *organizationsService) List(ctx context.Context) ([]*Organization, error
 	if err := o.client.do(ctx, req, &orgResponse); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(orgResponse.Pagination)
 
 	return orgResponse.Organizations, nil
 }
 
+// All returns an iterator over all organizations of a user, fetching pages as
+// needed.
+func (o *organizationsService) All(ctx context.Context, opts ...ListOption) iter.Seq2[*Organization, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*Organization, error) {
+		return o.List(ctx, opts...)
+	})
+}
+
 type listRegionsResponse struct {
 	Regions []*Region `json:"data"`
 }
 
 func (o *organizationsService) ListRegions(ctx context.Context, listReq *ListOrganizationRegionsRequest) ([]*Region, error) {
-	req, err := o.client.newRequest(http.MethodGet, path.Join(organizationsAPIPath, listReq.Organization, "regions"), nil)
+	req, err := o.client.newRequest(http.MethodGet, path.Join(organizationsAPIPath, listReq.Organization, "regions"), nil, withOperation("Organizations", "ListRegions"))
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +160,7 @@ func (o *organizationsService) ListClusterSKUs(ctx context.Context, listReq *Lis
 		}
 	}
 
-	req, err := o.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := o.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("Organizations", "ListClusterSKUs"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -0,0 +1,110 @@
+package planetscale
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// defaultIteratorPerPage is the page size requested by iterators when the
+// caller doesn't set one with WithPerPage.
+const defaultIteratorPerPage = 100
+
+// paginate returns an iterator over all items of a page-numbered list
+// endpoint. It requests pages in order, starting at the page set with
+// WithPage or at the first one, following the next page reported in the
+// response. If a response carries no pagination metadata, it requests the
+// following page until one comes back empty; the number of items on a page
+// says nothing since the API may cap the page size below the one requested.
+// Iteration stops at the first error, which is yielded once.
+func paginate[T any](ctx context.Context, opts []ListOption, list func(context.Context, ...ListOption) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		listOpts := &ListOptions{URLValues: &url.Values{}}
+		for _, opt := range opts {
+			if err := opt(listOpts); err != nil {
+				yield(zero, err)
+				return
+			}
+		}
+
+		perPage, _ := strconv.Atoi(listOpts.URLValues.Get("per_page"))
+		if perPage <= 0 {
+			perPage = defaultIteratorPerPage
+		}
+		page, _ := strconv.Atoi(listOpts.URLValues.Get("page"))
+		if page <= 0 {
+			page = 1
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var pagination Pagination
+			pageOpts := append(opts[:len(opts):len(opts)], WithPage(page), WithPerPage(perPage), WithPaginationInfo(&pagination))
+			items, err := list(ctx, pageOpts...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if pagination.CurrentPage > 0 {
+				if pagination.NextPage == nil || *pagination.NextPage <= page {
+					return
+				}
+				page = *pagination.NextPage
+				continue
+			}
+
+			if len(items) == 0 {
+				return
+			}
+			page++
+		}
+	}
+}
+
+// paginateCursor returns an iterator over all items of a cursor-paginated
+// list endpoint. It follows CursorEnd for as long as the API reports a next
+// page. Iteration stops at the first error, which is yielded once.
+func paginateCursor[T any](ctx context.Context, opts []ListOption, list func(context.Context, ...ListOption) (*CursorPaginatedResponse[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		pageOpts := opts[:len(opts):len(opts)]
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			resp, err := list(ctx, pageOpts...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range resp.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !resp.HasNext || resp.CursorEnd == nil || *resp.CursorEnd == "" {
+				return
+			}
+			pageOpts = append(opts[:len(opts):len(opts)], WithStartingAfter(*resp.CursorEnd))
+		}
+	}
+}
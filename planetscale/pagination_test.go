@@ -0,0 +1,126 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestPaginate_StopsWhenLoopBreaks(t *testing.T) {
+	c := qt.New(t)
+
+	calls := 0
+	list := func(ctx context.Context, opts ...ListOption) ([]int, error) {
+		calls++
+		return []int{1, 2}, nil
+	}
+
+	var got []int
+	for n, err := range paginate(context.Background(), []ListOption{WithPerPage(2)}, list) {
+		c.Assert(err, qt.IsNil)
+		got = append(got, n)
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	c.Assert(got, qt.DeepEquals, []int{1, 2, 1})
+	c.Assert(calls, qt.Equals, 2)
+}
+
+func TestPaginate_StartsAtGivenPage(t *testing.T) {
+	c := qt.New(t)
+
+	var pages []string
+	list := func(ctx context.Context, opts ...ListOption) ([]int, error) {
+		listOpts := defaultListOptions(opts...)
+		pages = append(pages, listOpts.URLValues.Get("page"))
+		return nil, nil
+	}
+
+	for _, err := range paginate(context.Background(), []ListOption{WithPage(3)}, list) {
+		c.Assert(err, qt.IsNil)
+	}
+
+	c.Assert(pages, qt.DeepEquals, []string{"3"})
+}
+
+func TestPaginate_CappedPageSizeWithoutMetadata(t *testing.T) {
+	c := qt.New(t)
+
+	// The server serves at most two items per page regardless of the
+	// requested page size, and reports no pagination metadata.
+	items := []int{1, 2, 3, 4, 5}
+	list := func(ctx context.Context, opts ...ListOption) ([]int, error) {
+		listOpts := defaultListOptions(opts...)
+		page, err := strconv.Atoi(listOpts.URLValues.Get("page"))
+		c.Assert(err, qt.IsNil)
+		start := min((page-1)*2, len(items))
+		return items[start:min(start+2, len(items))], nil
+	}
+
+	var got []int
+	for n, err := range paginate(context.Background(), nil, list) {
+		c.Assert(err, qt.IsNil)
+		got = append(got, n)
+	}
+
+	c.Assert(got, qt.DeepEquals, items)
+}
+
+func TestPaginate_YieldsErrors(t *testing.T) {
+	c := qt.New(t)
+
+	wantErr := errors.New("boom")
+	list := func(ctx context.Context, opts ...ListOption) ([]int, error) {
+		return nil, wantErr
+	}
+
+	var errs []error
+	for _, err := range paginate(context.Background(), nil, list) {
+		errs = append(errs, err)
+	}
+
+	c.Assert(errs, qt.HasLen, 1)
+	c.Assert(errs[0], qt.Equals, wantErr)
+}
+
+func TestPaginate_RespectsContextCancellation(t *testing.T) {
+	c := qt.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	list := func(ctx context.Context, opts ...ListOption) ([]int, error) {
+		cancel()
+		return []int{1}, nil
+	}
+
+	var errs []error
+	for _, err := range paginate(ctx, []ListOption{WithPerPage(1)}, list) {
+		errs = append(errs, err)
+	}
+
+	c.Assert(errs, qt.HasLen, 2)
+	c.Assert(errs[0], qt.IsNil)
+	c.Assert(errs[1], qt.ErrorIs, context.Canceled)
+}
+
+func TestPaginateCursor_StopsWithoutCursor(t *testing.T) {
+	c := qt.New(t)
+
+	calls := 0
+	list := func(ctx context.Context, opts ...ListOption) (*CursorPaginatedResponse[int], error) {
+		calls++
+		return &CursorPaginatedResponse[int]{Data: []int{calls}, HasNext: true}, nil
+	}
+
+	var got []int
+	for n, err := range paginateCursor(context.Background(), nil, list) {
+		c.Assert(err, qt.IsNil)
+		got = append(got, n)
+	}
+
+	c.Assert(got, qt.DeepEquals, []int{1})
+}
@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -41,6 +42,10 @@ type DatabaseBranchPasswordRequest struct {
 	TTL              int    `json:"ttl,omitempty"`
 	Replica          bool   `json:"replica,omitempty"`
 	ReadOnlyRegionID string `json:"read_only_region_id,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // ListDatabaseBranchPasswordRequest encapsulates the request for listing all passwords
@@ -86,6 +91,7 @@ type PasswordsService interface {
 	Create(context.Context, *DatabaseBranchPasswordRequest) (*DatabaseBranchPassword, error)
 	// List returns passwords with optional pagination support via ListOption parameters
 	List(context.Context, *ListDatabaseBranchPasswordRequest, ...ListOption) ([]*DatabaseBranchPassword, error)
+	All(context.Context, *ListDatabaseBranchPasswordRequest, ...ListOption) iter.Seq2[*DatabaseBranchPassword, error]
 	Get(context.Context, *GetDatabaseBranchPasswordRequest) (*DatabaseBranchPassword, error)
 	Delete(context.Context, *DeleteDatabaseBranchPasswordRequest) error
 	Renew(context.Context, *RenewDatabaseBranchPasswordRequest) (*DatabaseBranchPassword, error)
@@ -96,6 +102,7 @@ type passwordsService struct {
 }
 
 type passwordsResponse struct {
+	Pagination
 	Passwords []*DatabaseBranchPassword `json:"data"`
 }
 
@@ -110,7 +117,7 @@ func NewPasswordsService(client *Client) *passwordsService {
 // Creates a new password for a branch.
 func (d *passwordsService) Create(ctx context.Context, createReq *DatabaseBranchPasswordRequest) (*DatabaseBranchPassword, error) {
 	pathStr := passwordsBranchAPIPath(createReq.Organization, createReq.Database, createReq.Branch)
-	req, err := d.client.newRequest(http.MethodPost, pathStr, createReq)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("Passwords", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -126,7 +133,7 @@ func (d *passwordsService) Create(ctx context.Context, createReq *DatabaseBranch
 // Delete an existing password for a branch.
 func (d *passwordsService) Delete(ctx context.Context, deleteReq *DeleteDatabaseBranchPasswordRequest) error {
 	pathStr := passwordBranchAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.PasswordId)
-	req, err := d.client.newRequest(http.MethodDelete, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodDelete, pathStr, nil, withOperation("Passwords", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -138,7 +145,7 @@ func (d *passwordsService) Delete(ctx context.Context, deleteReq *DeleteDatabase
 // Get an existing password for a branch.
 func (d *passwordsService) Get(ctx context.Context, getReq *GetDatabaseBranchPasswordRequest) (*DatabaseBranchPassword, error) {
 	pathStr := passwordBranchAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.PasswordId)
-	req, err := d.client.newRequest(http.MethodGet, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodGet, pathStr, nil, withOperation("Passwords", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -167,7 +174,7 @@ func (d *passwordsService) List(ctx context.Context, listReq *ListDatabaseBranch
 		}
 	}
 
-	req, err := d.client.newRequest(http.MethodGet, pathStr, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := d.client.newRequest(http.MethodGet, pathStr, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("Passwords", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request to list passwords: %w", err)
 	}
@@ -176,13 +183,22 @@ func (d *passwordsService) List(ctx context.Context, listReq *ListDatabaseBranch
 	if err := d.client.do(ctx, req, &passwordsResp); err != nil {
 		return nil, err
 	}
+	defaultOpts.setPagination(passwordsResp.Pagination)
 
 	return passwordsResp.Passwords, nil
 }
 
+// All returns an iterator over all passwords of a database or database branch,
+// fetching pages as needed.
+func (d *passwordsService) All(ctx context.Context, listReq *ListDatabaseBranchPasswordRequest, opts ...ListOption) iter.Seq2[*DatabaseBranchPassword, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*DatabaseBranchPassword, error) {
+		return d.List(ctx, listReq, opts...)
+	})
+}
+
 func (d *passwordsService) Renew(ctx context.Context, renewReq *RenewDatabaseBranchPasswordRequest) (*DatabaseBranchPassword, error) {
 	pathStr := passwordRenewAPIPath(renewReq.Organization, renewReq.Database, renewReq.Branch, renewReq.PasswordId)
-	req, err := d.client.newRequest(http.MethodPost, pathStr, nil)
+	req, err := d.client.newRequest(http.MethodPost, pathStr, nil, withOperation("Passwords", "Renew"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
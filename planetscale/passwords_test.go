@@ -295,6 +295,46 @@ func TestPasswords_ListWithFilters(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 }
 
+func TestPasswords_All(t *testing.T) {
+	c := qt.New(t)
+
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.URL.Query().Get("per_page"), qt.Equals, "2")
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+
+		w.WriteHeader(200)
+		var out string
+		switch page {
+		case "1":
+			out = `{"current_page": 1, "next_page": 2, "data": [{"id": "pw-1"}, {"id": "pw-2"}]}`
+		case "2":
+			out = `{"current_page": 2, "next_page": null, "data": [{"id": "pw-3"}]}`
+		default:
+			t.Fatalf("unexpected page %q", page)
+		}
+		_, err := w.Write([]byte(out))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var ids []string
+	for password, err := range client.Passwords.All(context.Background(), &ListDatabaseBranchPasswordRequest{
+		Organization: "my-org",
+		Database:     "planetscale-go-test-db",
+	}, WithPerPage(2)) {
+		c.Assert(err, qt.IsNil)
+		ids = append(ids, password.PublicID)
+	}
+
+	c.Assert(ids, qt.DeepEquals, []string{"pw-1", "pw-2", "pw-3"})
+	c.Assert(pages, qt.DeepEquals, []string{"1", "2"})
+}
+
 func TestPasswords_Get(t *testing.T) {
 	c := qt.New(t)
 
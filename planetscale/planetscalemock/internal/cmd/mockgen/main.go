@@ -0,0 +1,24 @@
+// Command mockgen writes the mocks of the planetscalemock package.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/planetscale/planetscale-go/planetscale/planetscalemock/internal/mockgen"
+)
+
+func main() {
+	src := flag.String("src", "..", "directory of the planetscale package")
+	out := flag.String("o", "mocks_generated.go", "output file")
+	flag.Parse()
+
+	code, err := mockgen.Generate(*src)
+	if err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+}
@@ -0,0 +1,477 @@
+// Package mockgen generates the mocks of the planetscalemock package from the
+// service interfaces of the planetscale package.
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	pkgName       = "planetscale"
+	pkgImportPath = "github.com/planetscale/planetscale-go/planetscale"
+)
+
+type service struct {
+	Name    string
+	Methods []*method
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+
+	// Args are the parameters recorded for the call, i.e. all of them but a
+	// leading context.
+	Args []string
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+type clientField struct {
+	Name    string
+	Service string
+}
+
+// Signature returns the parameter and result lists of the method.
+func (m *method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + p.Type
+	}
+	return "(" + strings.Join(params, ", ") + ")" + m.resultList()
+}
+
+// FuncType returns the type of the method's configuration field.
+func (m *method) FuncType() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Type
+	}
+	return "func(" + strings.Join(params, ", ") + ")" + m.resultList()
+}
+
+func (m *method) resultList() string {
+	switch len(m.Results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + m.Results[0]
+	}
+	return " (" + strings.Join(m.Results, ", ") + ")"
+}
+
+// CallArgs returns the arguments passing the method's parameters on.
+func (m *method) CallArgs() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+		if p.Variadic {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// NotConfigured returns the values returned when the method's configuration
+// field is nil.
+func (m *method) NotConfigured(svc string) string {
+	errExpr := fmt.Sprintf("notConfigured(%q, %q)", svc, m.Name)
+	if len(m.Results) == 1 {
+		if elem, ok := strings.CutPrefix(m.Results[0], "iter.Seq2["); ok && strings.HasSuffix(elem, ", error]") {
+			return fmt.Sprintf("notConfiguredSeq2[%s](%s)", strings.TrimSuffix(elem, ", error]"), errExpr)
+		}
+	}
+
+	values := make([]string, len(m.Results))
+	for i, r := range m.Results {
+		switch {
+		case r == "error":
+			values[i] = errExpr
+		case strings.HasPrefix(r, "*"), strings.HasPrefix(r, "[]"), strings.HasPrefix(r, "map["):
+			values[i] = "nil"
+		default:
+			values[i] = "zero[" + r + "]()"
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// Generate returns the source of the mocks for the planetscale package in
+// dir.
+func Generate(dir string) ([]byte, error) {
+	p, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := p.clientFields()
+	if err != nil {
+		return nil, err
+	}
+
+	var services []*service
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		if seen[f.Service] {
+			continue
+		}
+		seen[f.Service] = true
+
+		svc, err := p.service(f.Service)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, svc)
+	}
+
+	var imports []string
+	for _, path := range p.usedImports {
+		imports = append(imports, strconv.Quote(path))
+	}
+	sort.Strings(imports)
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"Imports":  imports,
+		"Package":  strconv.Quote(pkgImportPath),
+		"Services": services,
+		"Fields":   fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return out, nil
+}
+
+type interfaceDecl struct {
+	typ  *ast.InterfaceType
+	file *ast.File
+}
+
+type parsedPackage struct {
+	types      map[string]bool
+	interfaces map[string]interfaceDecl
+	client     *ast.StructType
+
+	// usedImports maps the names of packages referenced by the service
+	// interfaces to their import paths.
+	usedImports map[string]string
+}
+
+func parsePackage(dir string) (*parsedPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parsedPackage{
+		types:       make(map[string]bool),
+		interfaces:  make(map[string]interfaceDecl),
+		usedImports: make(map[string]string),
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if file.Name.Name != pkgName {
+			return nil, fmt.Errorf("%s: unexpected package %s", name, file.Name.Name)
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				p.types[ts.Name.Name] = true
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					p.interfaces[ts.Name.Name] = interfaceDecl{typ: t, file: file}
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						p.client = t
+					}
+				}
+			}
+		}
+	}
+
+	if p.client == nil {
+		return nil, fmt.Errorf("no Client type found in %s", dir)
+	}
+	return p, nil
+}
+
+// clientFields returns the exported fields of Client holding services.
+func (p *parsedPackage) clientFields() ([]clientField, error) {
+	var fields []clientField
+	for _, f := range p.client.Fields.List {
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if _, ok := p.interfaces[ident.Name]; !ok {
+			continue
+		}
+		for _, name := range f.Names {
+			if name.IsExported() {
+				fields = append(fields, clientField{Name: name.Name, Service: ident.Name})
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no service fields found in Client")
+	}
+	return fields, nil
+}
+
+func (p *parsedPackage) service(name string) (*service, error) {
+	svc := &service{Name: name}
+	if err := p.addMethods(svc, name); err != nil {
+		return nil, err
+	}
+	sort.Slice(svc.Methods, func(i, j int) bool {
+		return svc.Methods[i].Name < svc.Methods[j].Name
+	})
+	return svc, nil
+}
+
+func (p *parsedPackage) addMethods(svc *service, iface string) error {
+	decl, ok := p.interfaces[iface]
+	if !ok {
+		return fmt.Errorf("interface %s not found", iface)
+	}
+
+	for _, f := range decl.typ.Methods.List {
+		switch t := f.Type.(type) {
+		case *ast.FuncType:
+			for _, name := range f.Names {
+				m, err := p.method(name.Name, t, decl.file)
+				if err != nil {
+					return fmt.Errorf("%s.%s: %w", iface, name.Name, err)
+				}
+				svc.Methods = append(svc.Methods, m)
+			}
+		case *ast.Ident:
+			if err := p.addMethods(svc, t.Name); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s: unsupported embedded type %T", iface, f.Type)
+		}
+	}
+	return nil
+}
+
+func (p *parsedPackage) method(name string, fn *ast.FuncType, file *ast.File) (*method, error) {
+	m := &method{Name: name}
+
+	for _, f := range fn.Params.List {
+		typ, err := p.typeString(f.Type, file)
+		if err != nil {
+			return nil, err
+		}
+		_, variadic := f.Type.(*ast.Ellipsis)
+
+		count := max(len(f.Names), 1)
+		for range count {
+			pname := "ctx"
+			if len(m.Params) > 0 || typ != "context.Context" {
+				pname = "p" + strconv.Itoa(len(m.Args))
+				m.Args = append(m.Args, pname)
+			}
+			m.Params = append(m.Params, param{Name: pname, Type: typ, Variadic: variadic})
+		}
+	}
+
+	if fn.Results != nil {
+		for _, f := range fn.Results.List {
+			typ, err := p.typeString(f.Type, file)
+			if err != nil {
+				return nil, err
+			}
+			for range max(len(f.Names), 1) {
+				m.Results = append(m.Results, typ)
+			}
+		}
+	}
+	return m, nil
+}
+
+// typeString formats a type expression from the planetscale package so it
+// can be used from another package.
+func (p *parsedPackage) typeString(expr ast.Expr, file *ast.File) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if p.types[t.Name] {
+			return pkgName + "." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		x, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported selector %T", t.X)
+		}
+		path, err := importPath(file, x.Name)
+		if err != nil {
+			return "", err
+		}
+		p.usedImports[x.Name] = path
+		return x.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := p.typeString(t.X, file)
+		return "*" + s, err
+	case *ast.Ellipsis:
+		s, err := p.typeString(t.Elt, file)
+		return "..." + s, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("unsupported array type")
+		}
+		s, err := p.typeString(t.Elt, file)
+		return "[]" + s, err
+	case *ast.MapType:
+		k, err := p.typeString(t.Key, file)
+		if err != nil {
+			return "", err
+		}
+		v, err := p.typeString(t.Value, file)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("unsupported non-empty interface type")
+		}
+		return "interface{}", nil
+	case *ast.IndexExpr:
+		return p.genericString(t.X, []ast.Expr{t.Index}, file)
+	case *ast.IndexListExpr:
+		return p.genericString(t.X, t.Indices, file)
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+func (p *parsedPackage) genericString(x ast.Expr, indices []ast.Expr, file *ast.File) (string, error) {
+	base, err := p.typeString(x, file)
+	if err != nil {
+		return "", err
+	}
+	args := make([]string, len(indices))
+	for i, index := range indices {
+		if args[i], err = p.typeString(index, file); err != nil {
+			return "", err
+		}
+	}
+	return base + "[" + strings.Join(args, ", ") + "]", nil
+}
+
+func importPath(file *ast.File, name string) (string, error) {
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return "", err
+		}
+		if imp.Name != nil {
+			if imp.Name.Name == name {
+				return path, nil
+			}
+			continue
+		}
+		if path == name || strings.HasSuffix(path, "/"+name) {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no import found for package %s", name)
+}
+
+var tmpl = template.Must(template.New("mocks").Parse(`// Code generated by mockgen from the planetscale package; DO NOT EDIT.
+
+package planetscalemock
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+
+	{{.Package}}
+)
+
+// Mocks holds the mock services of a client returned by NewClient.
+type Mocks struct {
+{{- range .Fields}}
+	{{.Name}} *{{.Service}}
+{{- end}}
+}
+
+// NewClient returns a client whose services are the returned mocks. The
+// client does not make HTTP requests: requests made with Client.Do or
+// Client.Raw fail with ErrNotConfigured.
+func NewClient() (*planetscale.Client, *Mocks) {
+	mocks := &Mocks{
+{{- range .Fields}}
+		{{.Name}}: &{{.Service}}{},
+{{- end}}
+	}
+
+	client := newClient()
+{{- range .Fields}}
+	client.{{.Name}} = mocks.{{.Name}}
+{{- end}}
+	return client, mocks
+}
+{{range $svc := .Services}}
+// {{$svc.Name}} is a mock implementation of planetscale.{{$svc.Name}}.
+type {{$svc.Name}} struct {
+	Recorder
+{{range $svc.Methods}}
+	{{.Name}}Func {{.FuncType}}
+{{- end}}
+}
+
+var _ planetscale.{{$svc.Name}} = (*{{$svc.Name}})(nil)
+{{range $svc.Methods}}
+// {{.Name}} records the call and calls {{.Name}}Func.
+func (m *{{$svc.Name}}) {{.Name}}{{.Signature}} {
+	m.record("{{.Name}}"{{range .Args}}, {{.}}{{end}})
+{{- if .Results}}
+	if m.{{.Name}}Func == nil {
+		return {{.NotConfigured $svc.Name}}
+	}
+	return m.{{.Name}}Func({{.CallArgs}})
+{{- else}}
+	if m.{{.Name}}Func != nil {
+		m.{{.Name}}Func({{.CallArgs}})
+	}
+{{- end}}
+}
+{{end}}
+{{- end}}
+`))
@@ -0,0 +1,120 @@
+// Package planetscalemock provides mock implementations of the service
+// interfaces of the planetscale package, for unit tests that should not make
+// HTTP requests.
+//
+// Every mock has a field per method, named after the method with a Func
+// suffix, that configures what the method does. All calls are recorded,
+// configured or not:
+//
+//	client, mocks := planetscalemock.NewClient()
+//	mocks.Databases.GetFunc = func(ctx context.Context, req *planetscale.GetDatabaseRequest) (*planetscale.Database, error) {
+//		return &planetscale.Database{Name: req.Database}, nil
+//	}
+//
+//	db, err := client.Databases.Get(ctx, &planetscale.GetDatabaseRequest{Organization: "my-org", Database: "my-db"})
+//	calls := mocks.Databases.CallsTo("Get")
+//
+// The mocks are generated from the planetscale package with go generate, so
+// they cover every method of every service.
+package planetscalemock
+
+//go:generate go run ./internal/cmd/mockgen -src .. -o mocks_generated.go
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"sync"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// ErrNotConfigured is returned by mock methods whose Func field is nil.
+var ErrNotConfigured = errors.New("mock method not configured")
+
+// Call is a recorded call of a mock method.
+type Call struct {
+	// Method is the name of the called method.
+	Method string
+
+	// Args are the arguments of the call, excluding the leading context.
+	Args []interface{}
+}
+
+// Recorder records the calls made to a mock. It is embedded in every mock.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made to the mock so far, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to the given method so far, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// notConfiguredTransport fails every request, so that the requests of a
+// mock client don't reach the network.
+type notConfiguredTransport struct{}
+
+func (notConfiguredTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrNotConfigured)
+}
+
+// newClient returns a client whose requests fail with ErrNotConfigured.
+func newClient() *planetscale.Client {
+	client, err := planetscale.NewClient(planetscale.WithHTTPClient(&http.Client{
+		Transport: notConfiguredTransport{},
+	}))
+	if err != nil {
+		// NewClient only fails on bad options.
+		panic(err)
+	}
+	return client
+}
+
+func notConfigured(service, method string) error {
+	return fmt.Errorf("%s.%s: %w", service, method, ErrNotConfigured)
+}
+
+// notConfiguredSeq2 returns an iterator yielding err once.
+func notConfiguredSeq2[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
+
+func zero[T any]() T {
+	var v T
+	return v
+}
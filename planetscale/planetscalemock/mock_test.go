@@ -0,0 +1,81 @@
+package planetscalemock
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/planetscale/planetscale-go/planetscale"
+	"github.com/planetscale/planetscale-go/planetscale/planetscalemock/internal/mockgen"
+)
+
+func TestNewClient(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	client, mocks := NewClient()
+	mocks.Databases.GetFunc = func(ctx context.Context, req *planetscale.GetDatabaseRequest) (*planetscale.Database, error) {
+		return &planetscale.Database{Name: req.Database}, nil
+	}
+
+	getReq := &planetscale.GetDatabaseRequest{Organization: "my-org", Database: "my-db"}
+	db, err := client.Databases.Get(ctx, getReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(db.Name, qt.Equals, "my-db")
+
+	_, err = client.Databases.List(ctx, &planetscale.ListDatabasesRequest{Organization: "my-org"}, planetscale.WithPerPage(10))
+	c.Assert(err, qt.ErrorIs, ErrNotConfigured)
+	c.Assert(err, qt.ErrorMatches, "DatabasesService.List: mock method not configured")
+
+	calls := mocks.Databases.Calls()
+	c.Assert(calls, qt.HasLen, 2)
+	c.Assert(calls[0], qt.DeepEquals, Call{Method: "Get", Args: []interface{}{getReq}})
+	c.Assert(calls[1].Method, qt.Equals, "List")
+	c.Assert(calls[1].Args, qt.HasLen, 2)
+	c.Assert(mocks.Databases.CallsTo("Get"), qt.HasLen, 1)
+
+	mocks.Databases.Reset()
+	c.Assert(mocks.Databases.Calls(), qt.HasLen, 0)
+}
+
+func TestUnconfiguredIterator(t *testing.T) {
+	c := qt.New(t)
+
+	client, mocks := NewClient()
+	var errs []error
+	for _, err := range client.Organizations.All(context.Background()) {
+		errs = append(errs, err)
+	}
+	c.Assert(errs, qt.HasLen, 1)
+	c.Assert(errs[0], qt.ErrorIs, ErrNotConfigured)
+	c.Assert(mocks.Organizations.CallsTo("All"), qt.HasLen, 1)
+}
+
+func TestNewClientUnmockedRequests(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	client, _ := NewClient()
+	err := client.Raw(ctx, "GET", "v1/organizations", nil, nil)
+	c.Assert(err, qt.ErrorIs, ErrNotConfigured)
+
+	req, err := client.NewRequest("GET", "v1/organizations", nil)
+	c.Assert(err, qt.IsNil)
+	err = client.Do(ctx, req, nil)
+	c.Assert(err, qt.ErrorIs, ErrNotConfigured)
+}
+
+// TestGeneratedMocksUpToDate fails when the service interfaces changed
+// without running go generate.
+func TestGeneratedMocksUpToDate(t *testing.T) {
+	c := qt.New(t)
+
+	want, err := mockgen.Generate("..")
+	c.Assert(err, qt.IsNil)
+
+	got, err := os.ReadFile("mocks_generated.go")
+	c.Assert(err, qt.IsNil)
+	c.Assert(bytes.Equal(got, want), qt.IsTrue, qt.Commentf("mocks_generated.go is out of date, run go generate ./..."))
+}
@@ -0,0 +1,2701 @@
+// Code generated by mockgen from the planetscale package; DO NOT EDIT.
+
+package planetscalemock
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// Mocks holds the mock services of a client returned by NewClient.
+type Mocks struct {
+	AuditLogs             *AuditLogsService
+	AuthAttemptExports    *AuthAttemptExportsService
+	BackupPolicies        *BackupPoliciesService
+	Backups               *BackupsService
+	BranchInfrastructure  *BranchInfrastructureService
+	D1ImportNotifications *D1ImportNotificationsService
+	DatabaseBranches      *DatabaseBranchesService
+	Databases             *DatabasesService
+	DataImports           *DataImportsService
+	DeployRequests        *DeployRequestsService
+	Keyspaces             *KeyspacesService
+	LookupVindex          *LookupVindexService
+	Materialize           *MaterializeService
+	MoveTables            *MoveTablesService
+	Organizations         *OrganizationsService
+	Passwords             *PasswordsService
+	PlannedReparentShard  *PlannedReparentShardService
+	PostgresBranches      *PostgresBranchesService
+	PostgresBouncers      *PostgresBouncersService
+	PostgresCIDRs         *PostgresCIDRsService
+	PostgresRoles         *PostgresRolesService
+	Processlist           *ProcesslistService
+	QueryInsights         *QueryInsightsService
+	QueryPatterns         *QueryPatternsService
+	ReadOnlyRegions       *ReadOnlyRegionsService
+	Regions               *RegionsService
+	SchemaRecommendations *SchemaRecommendationService
+	ServiceTokens         *ServiceTokenService
+	TrafficBudgets        *TrafficBudgetsService
+	TrafficRules          *TrafficRulesService
+	VDiff                 *VDiffService
+	Vtctld                *VtctldService
+	Webhooks              *WebhooksService
+	Workflows             *WorkflowsService
+}
+
+// NewClient returns a client whose services are the returned mocks. The
+// client does not make HTTP requests: requests made with Client.Do or
+// Client.Raw fail with ErrNotConfigured.
+func NewClient() (*planetscale.Client, *Mocks) {
+	mocks := &Mocks{
+		AuditLogs:             &AuditLogsService{},
+		AuthAttemptExports:    &AuthAttemptExportsService{},
+		BackupPolicies:        &BackupPoliciesService{},
+		Backups:               &BackupsService{},
+		BranchInfrastructure:  &BranchInfrastructureService{},
+		D1ImportNotifications: &D1ImportNotificationsService{},
+		DatabaseBranches:      &DatabaseBranchesService{},
+		Databases:             &DatabasesService{},
+		DataImports:           &DataImportsService{},
+		DeployRequests:        &DeployRequestsService{},
+		Keyspaces:             &KeyspacesService{},
+		LookupVindex:          &LookupVindexService{},
+		Materialize:           &MaterializeService{},
+		MoveTables:            &MoveTablesService{},
+		Organizations:         &OrganizationsService{},
+		Passwords:             &PasswordsService{},
+		PlannedReparentShard:  &PlannedReparentShardService{},
+		PostgresBranches:      &PostgresBranchesService{},
+		PostgresBouncers:      &PostgresBouncersService{},
+		PostgresCIDRs:         &PostgresCIDRsService{},
+		PostgresRoles:         &PostgresRolesService{},
+		Processlist:           &ProcesslistService{},
+		QueryInsights:         &QueryInsightsService{},
+		QueryPatterns:         &QueryPatternsService{},
+		ReadOnlyRegions:       &ReadOnlyRegionsService{},
+		Regions:               &RegionsService{},
+		SchemaRecommendations: &SchemaRecommendationService{},
+		ServiceTokens:         &ServiceTokenService{},
+		TrafficBudgets:        &TrafficBudgetsService{},
+		TrafficRules:          &TrafficRulesService{},
+		VDiff:                 &VDiffService{},
+		Vtctld:                &VtctldService{},
+		Webhooks:              &WebhooksService{},
+		Workflows:             &WorkflowsService{},
+	}
+
+	client := newClient()
+	client.AuditLogs = mocks.AuditLogs
+	client.AuthAttemptExports = mocks.AuthAttemptExports
+	client.BackupPolicies = mocks.BackupPolicies
+	client.Backups = mocks.Backups
+	client.BranchInfrastructure = mocks.BranchInfrastructure
+	client.D1ImportNotifications = mocks.D1ImportNotifications
+	client.DatabaseBranches = mocks.DatabaseBranches
+	client.Databases = mocks.Databases
+	client.DataImports = mocks.DataImports
+	client.DeployRequests = mocks.DeployRequests
+	client.Keyspaces = mocks.Keyspaces
+	client.LookupVindex = mocks.LookupVindex
+	client.Materialize = mocks.Materialize
+	client.MoveTables = mocks.MoveTables
+	client.Organizations = mocks.Organizations
+	client.Passwords = mocks.Passwords
+	client.PlannedReparentShard = mocks.PlannedReparentShard
+	client.PostgresBranches = mocks.PostgresBranches
+	client.PostgresBouncers = mocks.PostgresBouncers
+	client.PostgresCIDRs = mocks.PostgresCIDRs
+	client.PostgresRoles = mocks.PostgresRoles
+	client.Processlist = mocks.Processlist
+	client.QueryInsights = mocks.QueryInsights
+	client.QueryPatterns = mocks.QueryPatterns
+	client.ReadOnlyRegions = mocks.ReadOnlyRegions
+	client.Regions = mocks.Regions
+	client.SchemaRecommendations = mocks.SchemaRecommendations
+	client.ServiceTokens = mocks.ServiceTokens
+	client.TrafficBudgets = mocks.TrafficBudgets
+	client.TrafficRules = mocks.TrafficRules
+	client.VDiff = mocks.VDiff
+	client.Vtctld = mocks.Vtctld
+	client.Webhooks = mocks.Webhooks
+	client.Workflows = mocks.Workflows
+	return client, mocks
+}
+
+// AuditLogsService is a mock implementation of planetscale.AuditLogsService.
+type AuditLogsService struct {
+	Recorder
+
+	AllFunc  func(context.Context, *planetscale.ListAuditLogsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.AuditLog, error]
+	ListFunc func(context.Context, *planetscale.ListAuditLogsRequest, ...planetscale.ListOption) (*planetscale.CursorPaginatedResponse[*planetscale.AuditLog], error)
+}
+
+var _ planetscale.AuditLogsService = (*AuditLogsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *AuditLogsService) All(ctx context.Context, p0 *planetscale.ListAuditLogsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.AuditLog, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.AuditLog](notConfigured("AuditLogsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// List records the call and calls ListFunc.
+func (m *AuditLogsService) List(ctx context.Context, p0 *planetscale.ListAuditLogsRequest, p1 ...planetscale.ListOption) (*planetscale.CursorPaginatedResponse[*planetscale.AuditLog], error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("AuditLogsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// AuthAttemptExportsService is a mock implementation of planetscale.AuthAttemptExportsService.
+type AuthAttemptExportsService struct {
+	Recorder
+
+	CreateExportFunc   func(context.Context, *planetscale.CreateAuthAttemptExportRequest) (*planetscale.AuthAttemptExport, error)
+	DownloadExportFunc func(context.Context, *planetscale.DownloadAuthAttemptExportRequest) (io.ReadCloser, error)
+	GetExportFunc      func(context.Context, *planetscale.GetAuthAttemptExportRequest) (*planetscale.AuthAttemptExport, error)
+}
+
+var _ planetscale.AuthAttemptExportsService = (*AuthAttemptExportsService)(nil)
+
+// CreateExport records the call and calls CreateExportFunc.
+func (m *AuthAttemptExportsService) CreateExport(ctx context.Context, p0 *planetscale.CreateAuthAttemptExportRequest) (*planetscale.AuthAttemptExport, error) {
+	m.record("CreateExport", p0)
+	if m.CreateExportFunc == nil {
+		return nil, notConfigured("AuthAttemptExportsService", "CreateExport")
+	}
+	return m.CreateExportFunc(ctx, p0)
+}
+
+// DownloadExport records the call and calls DownloadExportFunc.
+func (m *AuthAttemptExportsService) DownloadExport(ctx context.Context, p0 *planetscale.DownloadAuthAttemptExportRequest) (io.ReadCloser, error) {
+	m.record("DownloadExport", p0)
+	if m.DownloadExportFunc == nil {
+		return zero[io.ReadCloser](), notConfigured("AuthAttemptExportsService", "DownloadExport")
+	}
+	return m.DownloadExportFunc(ctx, p0)
+}
+
+// GetExport records the call and calls GetExportFunc.
+func (m *AuthAttemptExportsService) GetExport(ctx context.Context, p0 *planetscale.GetAuthAttemptExportRequest) (*planetscale.AuthAttemptExport, error) {
+	m.record("GetExport", p0)
+	if m.GetExportFunc == nil {
+		return nil, notConfigured("AuthAttemptExportsService", "GetExport")
+	}
+	return m.GetExportFunc(ctx, p0)
+}
+
+// BackupPoliciesService is a mock implementation of planetscale.BackupPoliciesService.
+type BackupPoliciesService struct {
+	Recorder
+
+	AllFunc    func(context.Context, *planetscale.ListBackupPoliciesRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.BackupPolicy, error]
+	CreateFunc func(context.Context, *planetscale.CreateBackupPolicyRequest) (*planetscale.BackupPolicy, error)
+	DeleteFunc func(context.Context, *planetscale.DeleteBackupPolicyRequest) error
+	GetFunc    func(context.Context, *planetscale.GetBackupPolicyRequest) (*planetscale.BackupPolicy, error)
+	ListFunc   func(context.Context, *planetscale.ListBackupPoliciesRequest, ...planetscale.ListOption) ([]*planetscale.BackupPolicy, error)
+	UpdateFunc func(context.Context, *planetscale.UpdateBackupPolicyRequest) (*planetscale.BackupPolicy, error)
+}
+
+var _ planetscale.BackupPoliciesService = (*BackupPoliciesService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *BackupPoliciesService) All(ctx context.Context, p0 *planetscale.ListBackupPoliciesRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.BackupPolicy, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.BackupPolicy](notConfigured("BackupPoliciesService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *BackupPoliciesService) Create(ctx context.Context, p0 *planetscale.CreateBackupPolicyRequest) (*planetscale.BackupPolicy, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("BackupPoliciesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *BackupPoliciesService) Delete(ctx context.Context, p0 *planetscale.DeleteBackupPolicyRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("BackupPoliciesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *BackupPoliciesService) Get(ctx context.Context, p0 *planetscale.GetBackupPolicyRequest) (*planetscale.BackupPolicy, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("BackupPoliciesService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *BackupPoliciesService) List(ctx context.Context, p0 *planetscale.ListBackupPoliciesRequest, p1 ...planetscale.ListOption) ([]*planetscale.BackupPolicy, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("BackupPoliciesService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *BackupPoliciesService) Update(ctx context.Context, p0 *planetscale.UpdateBackupPolicyRequest) (*planetscale.BackupPolicy, error) {
+	m.record("Update", p0)
+	if m.UpdateFunc == nil {
+		return nil, notConfigured("BackupPoliciesService", "Update")
+	}
+	return m.UpdateFunc(ctx, p0)
+}
+
+// BackupsService is a mock implementation of planetscale.BackupsService.
+type BackupsService struct {
+	Recorder
+
+	AllFunc           func(context.Context, *planetscale.ListBackupsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.Backup, error]
+	CreateFunc        func(context.Context, *planetscale.CreateBackupRequest) (*planetscale.Backup, error)
+	DeleteFunc        func(context.Context, *planetscale.DeleteBackupRequest) error
+	GetFunc           func(context.Context, *planetscale.GetBackupRequest) (*planetscale.Backup, error)
+	ListFunc          func(context.Context, *planetscale.ListBackupsRequest, ...planetscale.ListOption) ([]*planetscale.Backup, error)
+	WaitForBackupFunc func(context.Context, *planetscale.WaitForBackupRequest, ...planetscale.WaitOption) (*planetscale.Backup, error)
+}
+
+var _ planetscale.BackupsService = (*BackupsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *BackupsService) All(ctx context.Context, p0 *planetscale.ListBackupsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.Backup, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.Backup](notConfigured("BackupsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *BackupsService) Create(ctx context.Context, p0 *planetscale.CreateBackupRequest) (*planetscale.Backup, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("BackupsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *BackupsService) Delete(ctx context.Context, p0 *planetscale.DeleteBackupRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("BackupsService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *BackupsService) Get(ctx context.Context, p0 *planetscale.GetBackupRequest) (*planetscale.Backup, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("BackupsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *BackupsService) List(ctx context.Context, p0 *planetscale.ListBackupsRequest, p1 ...planetscale.ListOption) ([]*planetscale.Backup, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("BackupsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// WaitForBackup records the call and calls WaitForBackupFunc.
+func (m *BackupsService) WaitForBackup(ctx context.Context, p0 *planetscale.WaitForBackupRequest, p1 ...planetscale.WaitOption) (*planetscale.Backup, error) {
+	m.record("WaitForBackup", p0, p1)
+	if m.WaitForBackupFunc == nil {
+		return nil, notConfigured("BackupsService", "WaitForBackup")
+	}
+	return m.WaitForBackupFunc(ctx, p0, p1...)
+}
+
+// BranchInfrastructureService is a mock implementation of planetscale.BranchInfrastructureService.
+type BranchInfrastructureService struct {
+	Recorder
+
+	GetFunc func(context.Context, *planetscale.GetBranchInfrastructureRequest) (*planetscale.BranchInfrastructure, error)
+}
+
+var _ planetscale.BranchInfrastructureService = (*BranchInfrastructureService)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *BranchInfrastructureService) Get(ctx context.Context, p0 *planetscale.GetBranchInfrastructureRequest) (*planetscale.BranchInfrastructure, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("BranchInfrastructureService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// D1ImportNotificationsService is a mock implementation of planetscale.D1ImportNotificationsService.
+type D1ImportNotificationsService struct {
+	Recorder
+
+	CreateFunc func(context.Context, *planetscale.CreateD1ImportNotificationRequest) error
+}
+
+var _ planetscale.D1ImportNotificationsService = (*D1ImportNotificationsService)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *D1ImportNotificationsService) Create(ctx context.Context, p0 *planetscale.CreateD1ImportNotificationRequest) error {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return notConfigured("D1ImportNotificationsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// DatabaseBranchesService is a mock implementation of planetscale.DatabaseBranchesService.
+type DatabaseBranchesService struct {
+	Recorder
+
+	AllFunc                   func(context.Context, *planetscale.ListDatabaseBranchesRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.DatabaseBranch, error]
+	CancelResizeFunc          func(context.Context, *planetscale.CancelBranchResizeRequest) error
+	CreateFunc                func(context.Context, *planetscale.CreateDatabaseBranchRequest) (*planetscale.DatabaseBranch, error)
+	DeleteFunc                func(context.Context, *planetscale.DeleteDatabaseBranchRequest) error
+	DemoteFunc                func(context.Context, *planetscale.DemoteRequest) (*planetscale.DatabaseBranch, error)
+	DiffFunc                  func(context.Context, *planetscale.DiffBranchRequest) ([]*planetscale.Diff, error)
+	DisableSafeMigrationsFunc func(context.Context, *planetscale.DisableSafeMigrationsRequest) (*planetscale.DatabaseBranch, error)
+	EnableSafeMigrationsFunc  func(context.Context, *planetscale.EnableSafeMigrationsRequest) (*planetscale.DatabaseBranch, error)
+	GetFunc                   func(context.Context, *planetscale.GetDatabaseBranchRequest) (*planetscale.DatabaseBranch, error)
+	LintSchemaFunc            func(context.Context, *planetscale.LintSchemaRequest) ([]*planetscale.SchemaLintError, error)
+	ListFunc                  func(context.Context, *planetscale.ListDatabaseBranchesRequest, ...planetscale.ListOption) ([]*planetscale.DatabaseBranch, error)
+	ListClusterSKUsFunc       func(context.Context, *planetscale.ListBranchClusterSKUsRequest, ...planetscale.ListOption) ([]*planetscale.ClusterSKU, error)
+	ListResizesFunc           func(context.Context, *planetscale.ListBranchResizesRequest) ([]*planetscale.BranchResizeRequest, error)
+	PromoteFunc               func(context.Context, *planetscale.PromoteRequest) (*planetscale.DatabaseBranch, error)
+	RefreshSchemaFunc         func(context.Context, *planetscale.RefreshSchemaRequest) error
+	ResizeFunc                func(context.Context, *planetscale.ResizeBranchRequest) (*planetscale.BranchResizeRequest, error)
+	ResizeStatusFunc          func(context.Context, *planetscale.BranchResizeStatusRequest) (*planetscale.BranchResizeRequest, error)
+	RoutingRulesFunc          func(context.Context, *planetscale.BranchRoutingRulesRequest) (*planetscale.RoutingRules, error)
+	SchemaFunc                func(context.Context, *planetscale.BranchSchemaRequest) ([]*planetscale.Diff, error)
+	UpdateRoutingRulesFunc    func(context.Context, *planetscale.UpdateBranchRoutingRulesRequest) (*planetscale.RoutingRules, error)
+	WaitForBranchReadyFunc    func(context.Context, *planetscale.WaitForBranchReadyRequest, ...planetscale.WaitOption) (*planetscale.DatabaseBranch, error)
+	WaitForResizeFunc         func(context.Context, *planetscale.WaitForBranchResizeRequest, ...planetscale.WaitOption) (*planetscale.BranchResizeRequest, error)
+}
+
+var _ planetscale.DatabaseBranchesService = (*DatabaseBranchesService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *DatabaseBranchesService) All(ctx context.Context, p0 *planetscale.ListDatabaseBranchesRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.DatabaseBranch, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.DatabaseBranch](notConfigured("DatabaseBranchesService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// CancelResize records the call and calls CancelResizeFunc.
+func (m *DatabaseBranchesService) CancelResize(ctx context.Context, p0 *planetscale.CancelBranchResizeRequest) error {
+	m.record("CancelResize", p0)
+	if m.CancelResizeFunc == nil {
+		return notConfigured("DatabaseBranchesService", "CancelResize")
+	}
+	return m.CancelResizeFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *DatabaseBranchesService) Create(ctx context.Context, p0 *planetscale.CreateDatabaseBranchRequest) (*planetscale.DatabaseBranch, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *DatabaseBranchesService) Delete(ctx context.Context, p0 *planetscale.DeleteDatabaseBranchRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("DatabaseBranchesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Demote records the call and calls DemoteFunc.
+func (m *DatabaseBranchesService) Demote(ctx context.Context, p0 *planetscale.DemoteRequest) (*planetscale.DatabaseBranch, error) {
+	m.record("Demote", p0)
+	if m.DemoteFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Demote")
+	}
+	return m.DemoteFunc(ctx, p0)
+}
+
+// Diff records the call and calls DiffFunc.
+func (m *DatabaseBranchesService) Diff(ctx context.Context, p0 *planetscale.DiffBranchRequest) ([]*planetscale.Diff, error) {
+	m.record("Diff", p0)
+	if m.DiffFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Diff")
+	}
+	return m.DiffFunc(ctx, p0)
+}
+
+// DisableSafeMigrations records the call and calls DisableSafeMigrationsFunc.
+func (m *DatabaseBranchesService) DisableSafeMigrations(ctx context.Context, p0 *planetscale.DisableSafeMigrationsRequest) (*planetscale.DatabaseBranch, error) {
+	m.record("DisableSafeMigrations", p0)
+	if m.DisableSafeMigrationsFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "DisableSafeMigrations")
+	}
+	return m.DisableSafeMigrationsFunc(ctx, p0)
+}
+
+// EnableSafeMigrations records the call and calls EnableSafeMigrationsFunc.
+func (m *DatabaseBranchesService) EnableSafeMigrations(ctx context.Context, p0 *planetscale.EnableSafeMigrationsRequest) (*planetscale.DatabaseBranch, error) {
+	m.record("EnableSafeMigrations", p0)
+	if m.EnableSafeMigrationsFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "EnableSafeMigrations")
+	}
+	return m.EnableSafeMigrationsFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *DatabaseBranchesService) Get(ctx context.Context, p0 *planetscale.GetDatabaseBranchRequest) (*planetscale.DatabaseBranch, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// LintSchema records the call and calls LintSchemaFunc.
+func (m *DatabaseBranchesService) LintSchema(ctx context.Context, p0 *planetscale.LintSchemaRequest) ([]*planetscale.SchemaLintError, error) {
+	m.record("LintSchema", p0)
+	if m.LintSchemaFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "LintSchema")
+	}
+	return m.LintSchemaFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *DatabaseBranchesService) List(ctx context.Context, p0 *planetscale.ListDatabaseBranchesRequest, p1 ...planetscale.ListOption) ([]*planetscale.DatabaseBranch, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// ListClusterSKUs records the call and calls ListClusterSKUsFunc.
+func (m *DatabaseBranchesService) ListClusterSKUs(ctx context.Context, p0 *planetscale.ListBranchClusterSKUsRequest, p1 ...planetscale.ListOption) ([]*planetscale.ClusterSKU, error) {
+	m.record("ListClusterSKUs", p0, p1)
+	if m.ListClusterSKUsFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "ListClusterSKUs")
+	}
+	return m.ListClusterSKUsFunc(ctx, p0, p1...)
+}
+
+// ListResizes records the call and calls ListResizesFunc.
+func (m *DatabaseBranchesService) ListResizes(ctx context.Context, p0 *planetscale.ListBranchResizesRequest) ([]*planetscale.BranchResizeRequest, error) {
+	m.record("ListResizes", p0)
+	if m.ListResizesFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "ListResizes")
+	}
+	return m.ListResizesFunc(ctx, p0)
+}
+
+// Promote records the call and calls PromoteFunc.
+func (m *DatabaseBranchesService) Promote(ctx context.Context, p0 *planetscale.PromoteRequest) (*planetscale.DatabaseBranch, error) {
+	m.record("Promote", p0)
+	if m.PromoteFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Promote")
+	}
+	return m.PromoteFunc(ctx, p0)
+}
+
+// RefreshSchema records the call and calls RefreshSchemaFunc.
+func (m *DatabaseBranchesService) RefreshSchema(ctx context.Context, p0 *planetscale.RefreshSchemaRequest) error {
+	m.record("RefreshSchema", p0)
+	if m.RefreshSchemaFunc == nil {
+		return notConfigured("DatabaseBranchesService", "RefreshSchema")
+	}
+	return m.RefreshSchemaFunc(ctx, p0)
+}
+
+// Resize records the call and calls ResizeFunc.
+func (m *DatabaseBranchesService) Resize(ctx context.Context, p0 *planetscale.ResizeBranchRequest) (*planetscale.BranchResizeRequest, error) {
+	m.record("Resize", p0)
+	if m.ResizeFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Resize")
+	}
+	return m.ResizeFunc(ctx, p0)
+}
+
+// ResizeStatus records the call and calls ResizeStatusFunc.
+func (m *DatabaseBranchesService) ResizeStatus(ctx context.Context, p0 *planetscale.BranchResizeStatusRequest) (*planetscale.BranchResizeRequest, error) {
+	m.record("ResizeStatus", p0)
+	if m.ResizeStatusFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "ResizeStatus")
+	}
+	return m.ResizeStatusFunc(ctx, p0)
+}
+
+// RoutingRules records the call and calls RoutingRulesFunc.
+func (m *DatabaseBranchesService) RoutingRules(ctx context.Context, p0 *planetscale.BranchRoutingRulesRequest) (*planetscale.RoutingRules, error) {
+	m.record("RoutingRules", p0)
+	if m.RoutingRulesFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "RoutingRules")
+	}
+	return m.RoutingRulesFunc(ctx, p0)
+}
+
+// Schema records the call and calls SchemaFunc.
+func (m *DatabaseBranchesService) Schema(ctx context.Context, p0 *planetscale.BranchSchemaRequest) ([]*planetscale.Diff, error) {
+	m.record("Schema", p0)
+	if m.SchemaFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "Schema")
+	}
+	return m.SchemaFunc(ctx, p0)
+}
+
+// UpdateRoutingRules records the call and calls UpdateRoutingRulesFunc.
+func (m *DatabaseBranchesService) UpdateRoutingRules(ctx context.Context, p0 *planetscale.UpdateBranchRoutingRulesRequest) (*planetscale.RoutingRules, error) {
+	m.record("UpdateRoutingRules", p0)
+	if m.UpdateRoutingRulesFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "UpdateRoutingRules")
+	}
+	return m.UpdateRoutingRulesFunc(ctx, p0)
+}
+
+// WaitForBranchReady records the call and calls WaitForBranchReadyFunc.
+func (m *DatabaseBranchesService) WaitForBranchReady(ctx context.Context, p0 *planetscale.WaitForBranchReadyRequest, p1 ...planetscale.WaitOption) (*planetscale.DatabaseBranch, error) {
+	m.record("WaitForBranchReady", p0, p1)
+	if m.WaitForBranchReadyFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "WaitForBranchReady")
+	}
+	return m.WaitForBranchReadyFunc(ctx, p0, p1...)
+}
+
+// WaitForResize records the call and calls WaitForResizeFunc.
+func (m *DatabaseBranchesService) WaitForResize(ctx context.Context, p0 *planetscale.WaitForBranchResizeRequest, p1 ...planetscale.WaitOption) (*planetscale.BranchResizeRequest, error) {
+	m.record("WaitForResize", p0, p1)
+	if m.WaitForResizeFunc == nil {
+		return nil, notConfigured("DatabaseBranchesService", "WaitForResize")
+	}
+	return m.WaitForResizeFunc(ctx, p0, p1...)
+}
+
+// DatabasesService is a mock implementation of planetscale.DatabasesService.
+type DatabasesService struct {
+	Recorder
+
+	AllFunc            func(context.Context, *planetscale.ListDatabasesRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.Database, error]
+	CreateFunc         func(context.Context, *planetscale.CreateDatabaseRequest) (*planetscale.Database, error)
+	DeleteFunc         func(context.Context, *planetscale.DeleteDatabaseRequest) (*planetscale.DatabaseDeletionRequest, error)
+	GetFunc            func(context.Context, *planetscale.GetDatabaseRequest) (*planetscale.Database, error)
+	ListFunc           func(context.Context, *planetscale.ListDatabasesRequest, ...planetscale.ListOption) ([]*planetscale.Database, error)
+	UpdateSettingsFunc func(context.Context, *planetscale.UpdateDatabaseSettingsRequest) (*planetscale.Database, error)
+}
+
+var _ planetscale.DatabasesService = (*DatabasesService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *DatabasesService) All(ctx context.Context, p0 *planetscale.ListDatabasesRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.Database, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.Database](notConfigured("DatabasesService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *DatabasesService) Create(ctx context.Context, p0 *planetscale.CreateDatabaseRequest) (*planetscale.Database, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("DatabasesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *DatabasesService) Delete(ctx context.Context, p0 *planetscale.DeleteDatabaseRequest) (*planetscale.DatabaseDeletionRequest, error) {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return nil, notConfigured("DatabasesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *DatabasesService) Get(ctx context.Context, p0 *planetscale.GetDatabaseRequest) (*planetscale.Database, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("DatabasesService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *DatabasesService) List(ctx context.Context, p0 *planetscale.ListDatabasesRequest, p1 ...planetscale.ListOption) ([]*planetscale.Database, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("DatabasesService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// UpdateSettings records the call and calls UpdateSettingsFunc.
+func (m *DatabasesService) UpdateSettings(ctx context.Context, p0 *planetscale.UpdateDatabaseSettingsRequest) (*planetscale.Database, error) {
+	m.record("UpdateSettings", p0)
+	if m.UpdateSettingsFunc == nil {
+		return nil, notConfigured("DatabasesService", "UpdateSettings")
+	}
+	return m.UpdateSettingsFunc(ctx, p0)
+}
+
+// DataImportsService is a mock implementation of planetscale.DataImportsService.
+type DataImportsService struct {
+	Recorder
+
+	CancelDataImportFunc       func(context.Context, *planetscale.CancelDataImportRequest) error
+	DetachExternalDatabaseFunc func(context.Context, *planetscale.DetachExternalDatabaseRequest) (*planetscale.DataImport, error)
+	GetDataImportStatusFunc    func(context.Context, *planetscale.GetImportStatusRequest) (*planetscale.DataImport, error)
+	MakePlanetScalePrimaryFunc func(context.Context, *planetscale.MakePlanetScalePrimaryRequest) (*planetscale.DataImport, error)
+	MakePlanetScaleReplicaFunc func(context.Context, *planetscale.MakePlanetScaleReplicaRequest) (*planetscale.DataImport, error)
+	StartDataImportFunc        func(context.Context, *planetscale.StartDataImportRequest) (*planetscale.DataImport, error)
+	TestDataImportSourceFunc   func(context.Context, *planetscale.TestDataImportSourceRequest) (*planetscale.TestDataImportSourceResponse, error)
+}
+
+var _ planetscale.DataImportsService = (*DataImportsService)(nil)
+
+// CancelDataImport records the call and calls CancelDataImportFunc.
+func (m *DataImportsService) CancelDataImport(ctx context.Context, p0 *planetscale.CancelDataImportRequest) error {
+	m.record("CancelDataImport", p0)
+	if m.CancelDataImportFunc == nil {
+		return notConfigured("DataImportsService", "CancelDataImport")
+	}
+	return m.CancelDataImportFunc(ctx, p0)
+}
+
+// DetachExternalDatabase records the call and calls DetachExternalDatabaseFunc.
+func (m *DataImportsService) DetachExternalDatabase(ctx context.Context, p0 *planetscale.DetachExternalDatabaseRequest) (*planetscale.DataImport, error) {
+	m.record("DetachExternalDatabase", p0)
+	if m.DetachExternalDatabaseFunc == nil {
+		return nil, notConfigured("DataImportsService", "DetachExternalDatabase")
+	}
+	return m.DetachExternalDatabaseFunc(ctx, p0)
+}
+
+// GetDataImportStatus records the call and calls GetDataImportStatusFunc.
+func (m *DataImportsService) GetDataImportStatus(ctx context.Context, p0 *planetscale.GetImportStatusRequest) (*planetscale.DataImport, error) {
+	m.record("GetDataImportStatus", p0)
+	if m.GetDataImportStatusFunc == nil {
+		return nil, notConfigured("DataImportsService", "GetDataImportStatus")
+	}
+	return m.GetDataImportStatusFunc(ctx, p0)
+}
+
+// MakePlanetScalePrimary records the call and calls MakePlanetScalePrimaryFunc.
+func (m *DataImportsService) MakePlanetScalePrimary(ctx context.Context, p0 *planetscale.MakePlanetScalePrimaryRequest) (*planetscale.DataImport, error) {
+	m.record("MakePlanetScalePrimary", p0)
+	if m.MakePlanetScalePrimaryFunc == nil {
+		return nil, notConfigured("DataImportsService", "MakePlanetScalePrimary")
+	}
+	return m.MakePlanetScalePrimaryFunc(ctx, p0)
+}
+
+// MakePlanetScaleReplica records the call and calls MakePlanetScaleReplicaFunc.
+func (m *DataImportsService) MakePlanetScaleReplica(ctx context.Context, p0 *planetscale.MakePlanetScaleReplicaRequest) (*planetscale.DataImport, error) {
+	m.record("MakePlanetScaleReplica", p0)
+	if m.MakePlanetScaleReplicaFunc == nil {
+		return nil, notConfigured("DataImportsService", "MakePlanetScaleReplica")
+	}
+	return m.MakePlanetScaleReplicaFunc(ctx, p0)
+}
+
+// StartDataImport records the call and calls StartDataImportFunc.
+func (m *DataImportsService) StartDataImport(ctx context.Context, p0 *planetscale.StartDataImportRequest) (*planetscale.DataImport, error) {
+	m.record("StartDataImport", p0)
+	if m.StartDataImportFunc == nil {
+		return nil, notConfigured("DataImportsService", "StartDataImport")
+	}
+	return m.StartDataImportFunc(ctx, p0)
+}
+
+// TestDataImportSource records the call and calls TestDataImportSourceFunc.
+func (m *DataImportsService) TestDataImportSource(ctx context.Context, p0 *planetscale.TestDataImportSourceRequest) (*planetscale.TestDataImportSourceResponse, error) {
+	m.record("TestDataImportSource", p0)
+	if m.TestDataImportSourceFunc == nil {
+		return nil, notConfigured("DataImportsService", "TestDataImportSource")
+	}
+	return m.TestDataImportSourceFunc(ctx, p0)
+}
+
+// DeployRequestsService is a mock implementation of planetscale.DeployRequestsService.
+type DeployRequestsService struct {
+	Recorder
+
+	AllFunc                 func(context.Context, *planetscale.ListDeployRequestsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.DeployRequest, error]
+	ApplyDeployFunc         func(context.Context, *planetscale.ApplyDeployRequestRequest) (*planetscale.DeployRequest, error)
+	AutoApplyDeployFunc     func(context.Context, *planetscale.AutoApplyDeployRequestRequest) (*planetscale.DeployRequest, error)
+	CancelDeployFunc        func(context.Context, *planetscale.CancelDeployRequestRequest) (*planetscale.DeployRequest, error)
+	CloseDeployFunc         func(context.Context, *planetscale.CloseDeployRequestRequest) (*planetscale.DeployRequest, error)
+	CreateFunc              func(context.Context, *planetscale.CreateDeployRequestRequest) (*planetscale.DeployRequest, error)
+	CreateReviewFunc        func(context.Context, *planetscale.ReviewDeployRequestRequest) (*planetscale.DeployRequestReview, error)
+	DeployFunc              func(context.Context, *planetscale.PerformDeployRequest) (*planetscale.DeployRequest, error)
+	DiffFunc                func(context.Context, *planetscale.DiffRequest) ([]*planetscale.Diff, error)
+	ForceCutoverFunc        func(context.Context, *planetscale.ForceCutoverDeployRequestRequest) (*planetscale.DeployRequest, error)
+	GetFunc                 func(context.Context, *planetscale.GetDeployRequestRequest) (*planetscale.DeployRequest, error)
+	GetDeployOperationsFunc func(context.Context, *planetscale.GetDeployOperationsRequest) ([]*planetscale.DeployOperation, error)
+	ListFunc                func(context.Context, *planetscale.ListDeployRequestsRequest, ...planetscale.ListOption) ([]*planetscale.DeployRequest, error)
+	RevertDeployFunc        func(context.Context, *planetscale.RevertDeployRequestRequest) (*planetscale.DeployRequest, error)
+	SkipRevertDeployFunc    func(context.Context, *planetscale.SkipRevertDeployRequestRequest) (*planetscale.DeployRequest, error)
+	WaitForDeploymentFunc   func(context.Context, *planetscale.WaitForDeploymentRequest, ...planetscale.WaitOption) (*planetscale.DeploymentStatus, error)
+}
+
+var _ planetscale.DeployRequestsService = (*DeployRequestsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *DeployRequestsService) All(ctx context.Context, p0 *planetscale.ListDeployRequestsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.DeployRequest, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.DeployRequest](notConfigured("DeployRequestsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// ApplyDeploy records the call and calls ApplyDeployFunc.
+func (m *DeployRequestsService) ApplyDeploy(ctx context.Context, p0 *planetscale.ApplyDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("ApplyDeploy", p0)
+	if m.ApplyDeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "ApplyDeploy")
+	}
+	return m.ApplyDeployFunc(ctx, p0)
+}
+
+// AutoApplyDeploy records the call and calls AutoApplyDeployFunc.
+func (m *DeployRequestsService) AutoApplyDeploy(ctx context.Context, p0 *planetscale.AutoApplyDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("AutoApplyDeploy", p0)
+	if m.AutoApplyDeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "AutoApplyDeploy")
+	}
+	return m.AutoApplyDeployFunc(ctx, p0)
+}
+
+// CancelDeploy records the call and calls CancelDeployFunc.
+func (m *DeployRequestsService) CancelDeploy(ctx context.Context, p0 *planetscale.CancelDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("CancelDeploy", p0)
+	if m.CancelDeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "CancelDeploy")
+	}
+	return m.CancelDeployFunc(ctx, p0)
+}
+
+// CloseDeploy records the call and calls CloseDeployFunc.
+func (m *DeployRequestsService) CloseDeploy(ctx context.Context, p0 *planetscale.CloseDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("CloseDeploy", p0)
+	if m.CloseDeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "CloseDeploy")
+	}
+	return m.CloseDeployFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *DeployRequestsService) Create(ctx context.Context, p0 *planetscale.CreateDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// CreateReview records the call and calls CreateReviewFunc.
+func (m *DeployRequestsService) CreateReview(ctx context.Context, p0 *planetscale.ReviewDeployRequestRequest) (*planetscale.DeployRequestReview, error) {
+	m.record("CreateReview", p0)
+	if m.CreateReviewFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "CreateReview")
+	}
+	return m.CreateReviewFunc(ctx, p0)
+}
+
+// Deploy records the call and calls DeployFunc.
+func (m *DeployRequestsService) Deploy(ctx context.Context, p0 *planetscale.PerformDeployRequest) (*planetscale.DeployRequest, error) {
+	m.record("Deploy", p0)
+	if m.DeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "Deploy")
+	}
+	return m.DeployFunc(ctx, p0)
+}
+
+// Diff records the call and calls DiffFunc.
+func (m *DeployRequestsService) Diff(ctx context.Context, p0 *planetscale.DiffRequest) ([]*planetscale.Diff, error) {
+	m.record("Diff", p0)
+	if m.DiffFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "Diff")
+	}
+	return m.DiffFunc(ctx, p0)
+}
+
+// ForceCutover records the call and calls ForceCutoverFunc.
+func (m *DeployRequestsService) ForceCutover(ctx context.Context, p0 *planetscale.ForceCutoverDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("ForceCutover", p0)
+	if m.ForceCutoverFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "ForceCutover")
+	}
+	return m.ForceCutoverFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *DeployRequestsService) Get(ctx context.Context, p0 *planetscale.GetDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// GetDeployOperations records the call and calls GetDeployOperationsFunc.
+func (m *DeployRequestsService) GetDeployOperations(ctx context.Context, p0 *planetscale.GetDeployOperationsRequest) ([]*planetscale.DeployOperation, error) {
+	m.record("GetDeployOperations", p0)
+	if m.GetDeployOperationsFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "GetDeployOperations")
+	}
+	return m.GetDeployOperationsFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *DeployRequestsService) List(ctx context.Context, p0 *planetscale.ListDeployRequestsRequest, p1 ...planetscale.ListOption) ([]*planetscale.DeployRequest, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// RevertDeploy records the call and calls RevertDeployFunc.
+func (m *DeployRequestsService) RevertDeploy(ctx context.Context, p0 *planetscale.RevertDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("RevertDeploy", p0)
+	if m.RevertDeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "RevertDeploy")
+	}
+	return m.RevertDeployFunc(ctx, p0)
+}
+
+// SkipRevertDeploy records the call and calls SkipRevertDeployFunc.
+func (m *DeployRequestsService) SkipRevertDeploy(ctx context.Context, p0 *planetscale.SkipRevertDeployRequestRequest) (*planetscale.DeployRequest, error) {
+	m.record("SkipRevertDeploy", p0)
+	if m.SkipRevertDeployFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "SkipRevertDeploy")
+	}
+	return m.SkipRevertDeployFunc(ctx, p0)
+}
+
+// WaitForDeployment records the call and calls WaitForDeploymentFunc.
+func (m *DeployRequestsService) WaitForDeployment(ctx context.Context, p0 *planetscale.WaitForDeploymentRequest, p1 ...planetscale.WaitOption) (*planetscale.DeploymentStatus, error) {
+	m.record("WaitForDeployment", p0, p1)
+	if m.WaitForDeploymentFunc == nil {
+		return nil, notConfigured("DeployRequestsService", "WaitForDeployment")
+	}
+	return m.WaitForDeploymentFunc(ctx, p0, p1...)
+}
+
+// KeyspacesService is a mock implementation of planetscale.KeyspacesService.
+type KeyspacesService struct {
+	Recorder
+
+	AllFunc                   func(context.Context, *planetscale.ListKeyspacesRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.Keyspace, error]
+	CancelResizeFunc          func(context.Context, *planetscale.CancelKeyspaceResizeRequest) error
+	CreateFunc                func(context.Context, *planetscale.CreateKeyspaceRequest) (*planetscale.Keyspace, error)
+	DeleteFunc                func(context.Context, *planetscale.DeleteKeyspaceRequest) error
+	GetFunc                   func(context.Context, *planetscale.GetKeyspaceRequest) (*planetscale.Keyspace, error)
+	ListFunc                  func(context.Context, *planetscale.ListKeyspacesRequest, ...planetscale.ListOption) ([]*planetscale.Keyspace, error)
+	ResizeFunc                func(context.Context, *planetscale.ResizeKeyspaceRequest) (*planetscale.KeyspaceResizeRequest, error)
+	ResizeStatusFunc          func(context.Context, *planetscale.KeyspaceResizeStatusRequest) (*planetscale.KeyspaceResizeRequest, error)
+	RolloutStatusFunc         func(context.Context, *planetscale.KeyspaceRolloutStatusRequest) (*planetscale.KeyspaceRollout, error)
+	UpdateReadOnlyRegionsFunc func(context.Context, *planetscale.UpdateReadOnlyRegionsRequest) ([]*planetscale.ReadOnlyRegionKeyspace, error)
+	UpdateSettingsFunc        func(context.Context, *planetscale.UpdateKeyspaceSettingsRequest) (*planetscale.Keyspace, error)
+	UpdateVSchemaFunc         func(context.Context, *planetscale.UpdateKeyspaceVSchemaRequest) (*planetscale.VSchema, error)
+	VSchemaFunc               func(context.Context, *planetscale.GetKeyspaceVSchemaRequest) (*planetscale.VSchema, error)
+	WaitForResizeFunc         func(context.Context, *planetscale.WaitForKeyspaceResizeRequest, ...planetscale.WaitOption) (*planetscale.KeyspaceResizeRequest, error)
+}
+
+var _ planetscale.KeyspacesService = (*KeyspacesService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *KeyspacesService) All(ctx context.Context, p0 *planetscale.ListKeyspacesRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.Keyspace, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.Keyspace](notConfigured("KeyspacesService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// CancelResize records the call and calls CancelResizeFunc.
+func (m *KeyspacesService) CancelResize(ctx context.Context, p0 *planetscale.CancelKeyspaceResizeRequest) error {
+	m.record("CancelResize", p0)
+	if m.CancelResizeFunc == nil {
+		return notConfigured("KeyspacesService", "CancelResize")
+	}
+	return m.CancelResizeFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *KeyspacesService) Create(ctx context.Context, p0 *planetscale.CreateKeyspaceRequest) (*planetscale.Keyspace, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("KeyspacesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *KeyspacesService) Delete(ctx context.Context, p0 *planetscale.DeleteKeyspaceRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("KeyspacesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *KeyspacesService) Get(ctx context.Context, p0 *planetscale.GetKeyspaceRequest) (*planetscale.Keyspace, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("KeyspacesService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *KeyspacesService) List(ctx context.Context, p0 *planetscale.ListKeyspacesRequest, p1 ...planetscale.ListOption) ([]*planetscale.Keyspace, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("KeyspacesService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// Resize records the call and calls ResizeFunc.
+func (m *KeyspacesService) Resize(ctx context.Context, p0 *planetscale.ResizeKeyspaceRequest) (*planetscale.KeyspaceResizeRequest, error) {
+	m.record("Resize", p0)
+	if m.ResizeFunc == nil {
+		return nil, notConfigured("KeyspacesService", "Resize")
+	}
+	return m.ResizeFunc(ctx, p0)
+}
+
+// ResizeStatus records the call and calls ResizeStatusFunc.
+func (m *KeyspacesService) ResizeStatus(ctx context.Context, p0 *planetscale.KeyspaceResizeStatusRequest) (*planetscale.KeyspaceResizeRequest, error) {
+	m.record("ResizeStatus", p0)
+	if m.ResizeStatusFunc == nil {
+		return nil, notConfigured("KeyspacesService", "ResizeStatus")
+	}
+	return m.ResizeStatusFunc(ctx, p0)
+}
+
+// RolloutStatus records the call and calls RolloutStatusFunc.
+func (m *KeyspacesService) RolloutStatus(ctx context.Context, p0 *planetscale.KeyspaceRolloutStatusRequest) (*planetscale.KeyspaceRollout, error) {
+	m.record("RolloutStatus", p0)
+	if m.RolloutStatusFunc == nil {
+		return nil, notConfigured("KeyspacesService", "RolloutStatus")
+	}
+	return m.RolloutStatusFunc(ctx, p0)
+}
+
+// UpdateReadOnlyRegions records the call and calls UpdateReadOnlyRegionsFunc.
+func (m *KeyspacesService) UpdateReadOnlyRegions(ctx context.Context, p0 *planetscale.UpdateReadOnlyRegionsRequest) ([]*planetscale.ReadOnlyRegionKeyspace, error) {
+	m.record("UpdateReadOnlyRegions", p0)
+	if m.UpdateReadOnlyRegionsFunc == nil {
+		return nil, notConfigured("KeyspacesService", "UpdateReadOnlyRegions")
+	}
+	return m.UpdateReadOnlyRegionsFunc(ctx, p0)
+}
+
+// UpdateSettings records the call and calls UpdateSettingsFunc.
+func (m *KeyspacesService) UpdateSettings(ctx context.Context, p0 *planetscale.UpdateKeyspaceSettingsRequest) (*planetscale.Keyspace, error) {
+	m.record("UpdateSettings", p0)
+	if m.UpdateSettingsFunc == nil {
+		return nil, notConfigured("KeyspacesService", "UpdateSettings")
+	}
+	return m.UpdateSettingsFunc(ctx, p0)
+}
+
+// UpdateVSchema records the call and calls UpdateVSchemaFunc.
+func (m *KeyspacesService) UpdateVSchema(ctx context.Context, p0 *planetscale.UpdateKeyspaceVSchemaRequest) (*planetscale.VSchema, error) {
+	m.record("UpdateVSchema", p0)
+	if m.UpdateVSchemaFunc == nil {
+		return nil, notConfigured("KeyspacesService", "UpdateVSchema")
+	}
+	return m.UpdateVSchemaFunc(ctx, p0)
+}
+
+// VSchema records the call and calls VSchemaFunc.
+func (m *KeyspacesService) VSchema(ctx context.Context, p0 *planetscale.GetKeyspaceVSchemaRequest) (*planetscale.VSchema, error) {
+	m.record("VSchema", p0)
+	if m.VSchemaFunc == nil {
+		return nil, notConfigured("KeyspacesService", "VSchema")
+	}
+	return m.VSchemaFunc(ctx, p0)
+}
+
+// WaitForResize records the call and calls WaitForResizeFunc.
+func (m *KeyspacesService) WaitForResize(ctx context.Context, p0 *planetscale.WaitForKeyspaceResizeRequest, p1 ...planetscale.WaitOption) (*planetscale.KeyspaceResizeRequest, error) {
+	m.record("WaitForResize", p0, p1)
+	if m.WaitForResizeFunc == nil {
+		return nil, notConfigured("KeyspacesService", "WaitForResize")
+	}
+	return m.WaitForResizeFunc(ctx, p0, p1...)
+}
+
+// LookupVindexService is a mock implementation of planetscale.LookupVindexService.
+type LookupVindexService struct {
+	Recorder
+
+	CancelFunc      func(context.Context, *planetscale.LookupVindexCancelRequest) (json.RawMessage, error)
+	CompleteFunc    func(context.Context, *planetscale.LookupVindexCompleteRequest) (json.RawMessage, error)
+	CreateFunc      func(context.Context, *planetscale.LookupVindexCreateRequest) (json.RawMessage, error)
+	ExternalizeFunc func(context.Context, *planetscale.LookupVindexExternalizeRequest) (json.RawMessage, error)
+	InternalizeFunc func(context.Context, *planetscale.LookupVindexInternalizeRequest) (json.RawMessage, error)
+	ShowFunc        func(context.Context, *planetscale.LookupVindexShowRequest) (json.RawMessage, error)
+}
+
+var _ planetscale.LookupVindexService = (*LookupVindexService)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *LookupVindexService) Cancel(ctx context.Context, p0 *planetscale.LookupVindexCancelRequest) (json.RawMessage, error) {
+	m.record("Cancel", p0)
+	if m.CancelFunc == nil {
+		return zero[json.RawMessage](), notConfigured("LookupVindexService", "Cancel")
+	}
+	return m.CancelFunc(ctx, p0)
+}
+
+// Complete records the call and calls CompleteFunc.
+func (m *LookupVindexService) Complete(ctx context.Context, p0 *planetscale.LookupVindexCompleteRequest) (json.RawMessage, error) {
+	m.record("Complete", p0)
+	if m.CompleteFunc == nil {
+		return zero[json.RawMessage](), notConfigured("LookupVindexService", "Complete")
+	}
+	return m.CompleteFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *LookupVindexService) Create(ctx context.Context, p0 *planetscale.LookupVindexCreateRequest) (json.RawMessage, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return zero[json.RawMessage](), notConfigured("LookupVindexService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Externalize records the call and calls ExternalizeFunc.
+func (m *LookupVindexService) Externalize(ctx context.Context, p0 *planetscale.LookupVindexExternalizeRequest) (json.RawMessage, error) {
+	m.record("Externalize", p0)
+	if m.ExternalizeFunc == nil {
+		return zero[json.RawMessage](), notConfigured("LookupVindexService", "Externalize")
+	}
+	return m.ExternalizeFunc(ctx, p0)
+}
+
+// Internalize records the call and calls InternalizeFunc.
+func (m *LookupVindexService) Internalize(ctx context.Context, p0 *planetscale.LookupVindexInternalizeRequest) (json.RawMessage, error) {
+	m.record("Internalize", p0)
+	if m.InternalizeFunc == nil {
+		return zero[json.RawMessage](), notConfigured("LookupVindexService", "Internalize")
+	}
+	return m.InternalizeFunc(ctx, p0)
+}
+
+// Show records the call and calls ShowFunc.
+func (m *LookupVindexService) Show(ctx context.Context, p0 *planetscale.LookupVindexShowRequest) (json.RawMessage, error) {
+	m.record("Show", p0)
+	if m.ShowFunc == nil {
+		return zero[json.RawMessage](), notConfigured("LookupVindexService", "Show")
+	}
+	return m.ShowFunc(ctx, p0)
+}
+
+// MaterializeService is a mock implementation of planetscale.MaterializeService.
+type MaterializeService struct {
+	Recorder
+
+	CancelFunc func(context.Context, *planetscale.MaterializeCancelRequest) (json.RawMessage, error)
+	CreateFunc func(context.Context, *planetscale.MaterializeCreateRequest) (json.RawMessage, error)
+	ShowFunc   func(context.Context, *planetscale.MaterializeShowRequest) (json.RawMessage, error)
+	StartFunc  func(context.Context, *planetscale.MaterializeStartRequest) (json.RawMessage, error)
+	StopFunc   func(context.Context, *planetscale.MaterializeStopRequest) (json.RawMessage, error)
+}
+
+var _ planetscale.MaterializeService = (*MaterializeService)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *MaterializeService) Cancel(ctx context.Context, p0 *planetscale.MaterializeCancelRequest) (json.RawMessage, error) {
+	m.record("Cancel", p0)
+	if m.CancelFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MaterializeService", "Cancel")
+	}
+	return m.CancelFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *MaterializeService) Create(ctx context.Context, p0 *planetscale.MaterializeCreateRequest) (json.RawMessage, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MaterializeService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Show records the call and calls ShowFunc.
+func (m *MaterializeService) Show(ctx context.Context, p0 *planetscale.MaterializeShowRequest) (json.RawMessage, error) {
+	m.record("Show", p0)
+	if m.ShowFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MaterializeService", "Show")
+	}
+	return m.ShowFunc(ctx, p0)
+}
+
+// Start records the call and calls StartFunc.
+func (m *MaterializeService) Start(ctx context.Context, p0 *planetscale.MaterializeStartRequest) (json.RawMessage, error) {
+	m.record("Start", p0)
+	if m.StartFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MaterializeService", "Start")
+	}
+	return m.StartFunc(ctx, p0)
+}
+
+// Stop records the call and calls StopFunc.
+func (m *MaterializeService) Stop(ctx context.Context, p0 *planetscale.MaterializeStopRequest) (json.RawMessage, error) {
+	m.record("Stop", p0)
+	if m.StopFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MaterializeService", "Stop")
+	}
+	return m.StopFunc(ctx, p0)
+}
+
+// MoveTablesService is a mock implementation of planetscale.MoveTablesService.
+type MoveTablesService struct {
+	Recorder
+
+	CancelFunc         func(context.Context, *planetscale.MoveTablesCancelRequest) (*planetscale.VtctldOperationReference, error)
+	CompleteFunc       func(context.Context, *planetscale.MoveTablesCompleteRequest) (*planetscale.VtctldOperationReference, error)
+	CreateFunc         func(context.Context, *planetscale.MoveTablesCreateRequest) (*planetscale.VtctldOperationReference, error)
+	ReverseTrafficFunc func(context.Context, *planetscale.MoveTablesReverseTrafficRequest) (*planetscale.VtctldOperationReference, error)
+	ShowFunc           func(context.Context, *planetscale.MoveTablesShowRequest) (json.RawMessage, error)
+	StatusFunc         func(context.Context, *planetscale.MoveTablesStatusRequest) (json.RawMessage, error)
+	SwitchTrafficFunc  func(context.Context, *planetscale.MoveTablesSwitchTrafficRequest) (*planetscale.VtctldOperationReference, error)
+}
+
+var _ planetscale.MoveTablesService = (*MoveTablesService)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *MoveTablesService) Cancel(ctx context.Context, p0 *planetscale.MoveTablesCancelRequest) (*planetscale.VtctldOperationReference, error) {
+	m.record("Cancel", p0)
+	if m.CancelFunc == nil {
+		return nil, notConfigured("MoveTablesService", "Cancel")
+	}
+	return m.CancelFunc(ctx, p0)
+}
+
+// Complete records the call and calls CompleteFunc.
+func (m *MoveTablesService) Complete(ctx context.Context, p0 *planetscale.MoveTablesCompleteRequest) (*planetscale.VtctldOperationReference, error) {
+	m.record("Complete", p0)
+	if m.CompleteFunc == nil {
+		return nil, notConfigured("MoveTablesService", "Complete")
+	}
+	return m.CompleteFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *MoveTablesService) Create(ctx context.Context, p0 *planetscale.MoveTablesCreateRequest) (*planetscale.VtctldOperationReference, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("MoveTablesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// ReverseTraffic records the call and calls ReverseTrafficFunc.
+func (m *MoveTablesService) ReverseTraffic(ctx context.Context, p0 *planetscale.MoveTablesReverseTrafficRequest) (*planetscale.VtctldOperationReference, error) {
+	m.record("ReverseTraffic", p0)
+	if m.ReverseTrafficFunc == nil {
+		return nil, notConfigured("MoveTablesService", "ReverseTraffic")
+	}
+	return m.ReverseTrafficFunc(ctx, p0)
+}
+
+// Show records the call and calls ShowFunc.
+func (m *MoveTablesService) Show(ctx context.Context, p0 *planetscale.MoveTablesShowRequest) (json.RawMessage, error) {
+	m.record("Show", p0)
+	if m.ShowFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MoveTablesService", "Show")
+	}
+	return m.ShowFunc(ctx, p0)
+}
+
+// Status records the call and calls StatusFunc.
+func (m *MoveTablesService) Status(ctx context.Context, p0 *planetscale.MoveTablesStatusRequest) (json.RawMessage, error) {
+	m.record("Status", p0)
+	if m.StatusFunc == nil {
+		return zero[json.RawMessage](), notConfigured("MoveTablesService", "Status")
+	}
+	return m.StatusFunc(ctx, p0)
+}
+
+// SwitchTraffic records the call and calls SwitchTrafficFunc.
+func (m *MoveTablesService) SwitchTraffic(ctx context.Context, p0 *planetscale.MoveTablesSwitchTrafficRequest) (*planetscale.VtctldOperationReference, error) {
+	m.record("SwitchTraffic", p0)
+	if m.SwitchTrafficFunc == nil {
+		return nil, notConfigured("MoveTablesService", "SwitchTraffic")
+	}
+	return m.SwitchTrafficFunc(ctx, p0)
+}
+
+// OrganizationsService is a mock implementation of planetscale.OrganizationsService.
+type OrganizationsService struct {
+	Recorder
+
+	AllFunc             func(context.Context, ...planetscale.ListOption) iter.Seq2[*planetscale.Organization, error]
+	GetFunc             func(context.Context, *planetscale.GetOrganizationRequest) (*planetscale.Organization, error)
+	ListFunc            func(context.Context, ...planetscale.ListOption) ([]*planetscale.Organization, error)
+	ListClusterSKUsFunc func(context.Context, *planetscale.ListOrganizationClusterSKUsRequest, ...planetscale.ListOption) ([]*planetscale.ClusterSKU, error)
+	ListRegionsFunc     func(context.Context, *planetscale.ListOrganizationRegionsRequest) ([]*planetscale.Region, error)
+}
+
+var _ planetscale.OrganizationsService = (*OrganizationsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *OrganizationsService) All(ctx context.Context, p0 ...planetscale.ListOption) iter.Seq2[*planetscale.Organization, error] {
+	m.record("All", p0)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.Organization](notConfigured("OrganizationsService", "All"))
+	}
+	return m.AllFunc(ctx, p0...)
+}
+
+// Get records the call and calls GetFunc.
+func (m *OrganizationsService) Get(ctx context.Context, p0 *planetscale.GetOrganizationRequest) (*planetscale.Organization, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("OrganizationsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *OrganizationsService) List(ctx context.Context, p0 ...planetscale.ListOption) ([]*planetscale.Organization, error) {
+	m.record("List", p0)
+	if m.ListFunc == nil {
+		return nil, notConfigured("OrganizationsService", "List")
+	}
+	return m.ListFunc(ctx, p0...)
+}
+
+// ListClusterSKUs records the call and calls ListClusterSKUsFunc.
+func (m *OrganizationsService) ListClusterSKUs(ctx context.Context, p0 *planetscale.ListOrganizationClusterSKUsRequest, p1 ...planetscale.ListOption) ([]*planetscale.ClusterSKU, error) {
+	m.record("ListClusterSKUs", p0, p1)
+	if m.ListClusterSKUsFunc == nil {
+		return nil, notConfigured("OrganizationsService", "ListClusterSKUs")
+	}
+	return m.ListClusterSKUsFunc(ctx, p0, p1...)
+}
+
+// ListRegions records the call and calls ListRegionsFunc.
+func (m *OrganizationsService) ListRegions(ctx context.Context, p0 *planetscale.ListOrganizationRegionsRequest) ([]*planetscale.Region, error) {
+	m.record("ListRegions", p0)
+	if m.ListRegionsFunc == nil {
+		return nil, notConfigured("OrganizationsService", "ListRegions")
+	}
+	return m.ListRegionsFunc(ctx, p0)
+}
+
+// PasswordsService is a mock implementation of planetscale.PasswordsService.
+type PasswordsService struct {
+	Recorder
+
+	AllFunc    func(context.Context, *planetscale.ListDatabaseBranchPasswordRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.DatabaseBranchPassword, error]
+	CreateFunc func(context.Context, *planetscale.DatabaseBranchPasswordRequest) (*planetscale.DatabaseBranchPassword, error)
+	DeleteFunc func(context.Context, *planetscale.DeleteDatabaseBranchPasswordRequest) error
+	GetFunc    func(context.Context, *planetscale.GetDatabaseBranchPasswordRequest) (*planetscale.DatabaseBranchPassword, error)
+	ListFunc   func(context.Context, *planetscale.ListDatabaseBranchPasswordRequest, ...planetscale.ListOption) ([]*planetscale.DatabaseBranchPassword, error)
+	RenewFunc  func(context.Context, *planetscale.RenewDatabaseBranchPasswordRequest) (*planetscale.DatabaseBranchPassword, error)
+}
+
+var _ planetscale.PasswordsService = (*PasswordsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *PasswordsService) All(ctx context.Context, p0 *planetscale.ListDatabaseBranchPasswordRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.DatabaseBranchPassword, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.DatabaseBranchPassword](notConfigured("PasswordsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *PasswordsService) Create(ctx context.Context, p0 *planetscale.DatabaseBranchPasswordRequest) (*planetscale.DatabaseBranchPassword, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("PasswordsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PasswordsService) Delete(ctx context.Context, p0 *planetscale.DeleteDatabaseBranchPasswordRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("PasswordsService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *PasswordsService) Get(ctx context.Context, p0 *planetscale.GetDatabaseBranchPasswordRequest) (*planetscale.DatabaseBranchPassword, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("PasswordsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *PasswordsService) List(ctx context.Context, p0 *planetscale.ListDatabaseBranchPasswordRequest, p1 ...planetscale.ListOption) ([]*planetscale.DatabaseBranchPassword, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("PasswordsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// Renew records the call and calls RenewFunc.
+func (m *PasswordsService) Renew(ctx context.Context, p0 *planetscale.RenewDatabaseBranchPasswordRequest) (*planetscale.DatabaseBranchPassword, error) {
+	m.record("Renew", p0)
+	if m.RenewFunc == nil {
+		return nil, notConfigured("PasswordsService", "Renew")
+	}
+	return m.RenewFunc(ctx, p0)
+}
+
+// PlannedReparentShardService is a mock implementation of planetscale.PlannedReparentShardService.
+type PlannedReparentShardService struct {
+	Recorder
+
+	CreateFunc func(context.Context, *planetscale.PlannedReparentShardRequest) (*planetscale.VtctldOperation, error)
+	GetFunc    func(context.Context, *planetscale.GetPlannedReparentShardRequest) (*planetscale.VtctldOperation, error)
+}
+
+var _ planetscale.PlannedReparentShardService = (*PlannedReparentShardService)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *PlannedReparentShardService) Create(ctx context.Context, p0 *planetscale.PlannedReparentShardRequest) (*planetscale.VtctldOperation, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("PlannedReparentShardService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *PlannedReparentShardService) Get(ctx context.Context, p0 *planetscale.GetPlannedReparentShardRequest) (*planetscale.VtctldOperation, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("PlannedReparentShardService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// PostgresBranchesService is a mock implementation of planetscale.PostgresBranchesService.
+type PostgresBranchesService struct {
+	Recorder
+
+	AllFunc                func(context.Context, *planetscale.ListPostgresBranchesRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresBranch, error]
+	CancelChangesFunc      func(context.Context, *planetscale.CancelPostgresBranchChangesRequest) error
+	CreateFunc             func(context.Context, *planetscale.CreatePostgresBranchRequest) (*planetscale.PostgresBranch, error)
+	DeleteFunc             func(context.Context, *planetscale.DeletePostgresBranchRequest) error
+	GetFunc                func(context.Context, *planetscale.GetPostgresBranchRequest) (*planetscale.PostgresBranch, error)
+	GetChangeFunc          func(context.Context, *planetscale.GetPostgresBranchChangeRequest) (*planetscale.PostgresBranchClusterResizeRequest, error)
+	ListFunc               func(context.Context, *planetscale.ListPostgresBranchesRequest, ...planetscale.ListOption) ([]*planetscale.PostgresBranch, error)
+	ListChangesFunc        func(context.Context, *planetscale.ListPostgresBranchChangesRequest) ([]*planetscale.PostgresBranchClusterResizeRequest, error)
+	ListClusterSKUsFunc    func(context.Context, *planetscale.ListBranchClusterSKUsRequest, ...planetscale.ListOption) ([]*planetscale.ClusterSKU, error)
+	ListParametersFunc     func(context.Context, *planetscale.ListPostgresParametersRequest) ([]*planetscale.PostgresParameter, error)
+	ResizeFunc             func(context.Context, *planetscale.ResizePostgresBranchRequest) (*planetscale.PostgresBranchClusterResizeRequest, error)
+	SchemaFunc             func(context.Context, *planetscale.PostgresBranchSchemaRequest) ([]*planetscale.PostgresBranchSchema, error)
+	WaitForBranchReadyFunc func(context.Context, *planetscale.WaitForPostgresBranchReadyRequest, ...planetscale.WaitOption) (*planetscale.PostgresBranch, error)
+	WaitForChangeFunc      func(context.Context, *planetscale.WaitForPostgresBranchChangeRequest, ...planetscale.WaitOption) (*planetscale.PostgresBranchClusterResizeRequest, error)
+}
+
+var _ planetscale.PostgresBranchesService = (*PostgresBranchesService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *PostgresBranchesService) All(ctx context.Context, p0 *planetscale.ListPostgresBranchesRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresBranch, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.PostgresBranch](notConfigured("PostgresBranchesService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// CancelChanges records the call and calls CancelChangesFunc.
+func (m *PostgresBranchesService) CancelChanges(ctx context.Context, p0 *planetscale.CancelPostgresBranchChangesRequest) error {
+	m.record("CancelChanges", p0)
+	if m.CancelChangesFunc == nil {
+		return notConfigured("PostgresBranchesService", "CancelChanges")
+	}
+	return m.CancelChangesFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *PostgresBranchesService) Create(ctx context.Context, p0 *planetscale.CreatePostgresBranchRequest) (*planetscale.PostgresBranch, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PostgresBranchesService) Delete(ctx context.Context, p0 *planetscale.DeletePostgresBranchRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("PostgresBranchesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *PostgresBranchesService) Get(ctx context.Context, p0 *planetscale.GetPostgresBranchRequest) (*planetscale.PostgresBranch, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// GetChange records the call and calls GetChangeFunc.
+func (m *PostgresBranchesService) GetChange(ctx context.Context, p0 *planetscale.GetPostgresBranchChangeRequest) (*planetscale.PostgresBranchClusterResizeRequest, error) {
+	m.record("GetChange", p0)
+	if m.GetChangeFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "GetChange")
+	}
+	return m.GetChangeFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *PostgresBranchesService) List(ctx context.Context, p0 *planetscale.ListPostgresBranchesRequest, p1 ...planetscale.ListOption) ([]*planetscale.PostgresBranch, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// ListChanges records the call and calls ListChangesFunc.
+func (m *PostgresBranchesService) ListChanges(ctx context.Context, p0 *planetscale.ListPostgresBranchChangesRequest) ([]*planetscale.PostgresBranchClusterResizeRequest, error) {
+	m.record("ListChanges", p0)
+	if m.ListChangesFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "ListChanges")
+	}
+	return m.ListChangesFunc(ctx, p0)
+}
+
+// ListClusterSKUs records the call and calls ListClusterSKUsFunc.
+func (m *PostgresBranchesService) ListClusterSKUs(ctx context.Context, p0 *planetscale.ListBranchClusterSKUsRequest, p1 ...planetscale.ListOption) ([]*planetscale.ClusterSKU, error) {
+	m.record("ListClusterSKUs", p0, p1)
+	if m.ListClusterSKUsFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "ListClusterSKUs")
+	}
+	return m.ListClusterSKUsFunc(ctx, p0, p1...)
+}
+
+// ListParameters records the call and calls ListParametersFunc.
+func (m *PostgresBranchesService) ListParameters(ctx context.Context, p0 *planetscale.ListPostgresParametersRequest) ([]*planetscale.PostgresParameter, error) {
+	m.record("ListParameters", p0)
+	if m.ListParametersFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "ListParameters")
+	}
+	return m.ListParametersFunc(ctx, p0)
+}
+
+// Resize records the call and calls ResizeFunc.
+func (m *PostgresBranchesService) Resize(ctx context.Context, p0 *planetscale.ResizePostgresBranchRequest) (*planetscale.PostgresBranchClusterResizeRequest, error) {
+	m.record("Resize", p0)
+	if m.ResizeFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "Resize")
+	}
+	return m.ResizeFunc(ctx, p0)
+}
+
+// Schema records the call and calls SchemaFunc.
+func (m *PostgresBranchesService) Schema(ctx context.Context, p0 *planetscale.PostgresBranchSchemaRequest) ([]*planetscale.PostgresBranchSchema, error) {
+	m.record("Schema", p0)
+	if m.SchemaFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "Schema")
+	}
+	return m.SchemaFunc(ctx, p0)
+}
+
+// WaitForBranchReady records the call and calls WaitForBranchReadyFunc.
+func (m *PostgresBranchesService) WaitForBranchReady(ctx context.Context, p0 *planetscale.WaitForPostgresBranchReadyRequest, p1 ...planetscale.WaitOption) (*planetscale.PostgresBranch, error) {
+	m.record("WaitForBranchReady", p0, p1)
+	if m.WaitForBranchReadyFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "WaitForBranchReady")
+	}
+	return m.WaitForBranchReadyFunc(ctx, p0, p1...)
+}
+
+// WaitForChange records the call and calls WaitForChangeFunc.
+func (m *PostgresBranchesService) WaitForChange(ctx context.Context, p0 *planetscale.WaitForPostgresBranchChangeRequest, p1 ...planetscale.WaitOption) (*planetscale.PostgresBranchClusterResizeRequest, error) {
+	m.record("WaitForChange", p0, p1)
+	if m.WaitForChangeFunc == nil {
+		return nil, notConfigured("PostgresBranchesService", "WaitForChange")
+	}
+	return m.WaitForChangeFunc(ctx, p0, p1...)
+}
+
+// PostgresBouncersService is a mock implementation of planetscale.PostgresBouncersService.
+type PostgresBouncersService struct {
+	Recorder
+
+	AllFunc           func(context.Context, *planetscale.ListPostgresBouncersRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresBouncer, error]
+	CancelResizesFunc func(context.Context, *planetscale.CancelPostgresBouncerResizesRequest) error
+	CreateFunc        func(context.Context, *planetscale.CreatePostgresBouncerRequest) (*planetscale.PostgresBouncer, error)
+	DeleteFunc        func(context.Context, *planetscale.DeletePostgresBouncerRequest) error
+	GetFunc           func(context.Context, *planetscale.GetPostgresBouncerRequest) (*planetscale.PostgresBouncer, error)
+	ListFunc          func(context.Context, *planetscale.ListPostgresBouncersRequest, ...planetscale.ListOption) ([]*planetscale.PostgresBouncer, error)
+	ListResizesFunc   func(context.Context, *planetscale.ListPostgresBouncerResizesRequest, ...planetscale.ListOption) ([]*planetscale.PostgresBouncerResizeRequest, error)
+	ResizeFunc        func(context.Context, *planetscale.ResizePostgresBouncerRequest) (*planetscale.PostgresBouncerResizeRequest, error)
+	WaitForResizeFunc func(context.Context, *planetscale.WaitForPostgresBouncerResizeRequest, ...planetscale.WaitOption) (*planetscale.PostgresBouncerResizeRequest, error)
+}
+
+var _ planetscale.PostgresBouncersService = (*PostgresBouncersService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *PostgresBouncersService) All(ctx context.Context, p0 *planetscale.ListPostgresBouncersRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresBouncer, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.PostgresBouncer](notConfigured("PostgresBouncersService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// CancelResizes records the call and calls CancelResizesFunc.
+func (m *PostgresBouncersService) CancelResizes(ctx context.Context, p0 *planetscale.CancelPostgresBouncerResizesRequest) error {
+	m.record("CancelResizes", p0)
+	if m.CancelResizesFunc == nil {
+		return notConfigured("PostgresBouncersService", "CancelResizes")
+	}
+	return m.CancelResizesFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *PostgresBouncersService) Create(ctx context.Context, p0 *planetscale.CreatePostgresBouncerRequest) (*planetscale.PostgresBouncer, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("PostgresBouncersService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PostgresBouncersService) Delete(ctx context.Context, p0 *planetscale.DeletePostgresBouncerRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("PostgresBouncersService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *PostgresBouncersService) Get(ctx context.Context, p0 *planetscale.GetPostgresBouncerRequest) (*planetscale.PostgresBouncer, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("PostgresBouncersService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *PostgresBouncersService) List(ctx context.Context, p0 *planetscale.ListPostgresBouncersRequest, p1 ...planetscale.ListOption) ([]*planetscale.PostgresBouncer, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("PostgresBouncersService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// ListResizes records the call and calls ListResizesFunc.
+func (m *PostgresBouncersService) ListResizes(ctx context.Context, p0 *planetscale.ListPostgresBouncerResizesRequest, p1 ...planetscale.ListOption) ([]*planetscale.PostgresBouncerResizeRequest, error) {
+	m.record("ListResizes", p0, p1)
+	if m.ListResizesFunc == nil {
+		return nil, notConfigured("PostgresBouncersService", "ListResizes")
+	}
+	return m.ListResizesFunc(ctx, p0, p1...)
+}
+
+// Resize records the call and calls ResizeFunc.
+func (m *PostgresBouncersService) Resize(ctx context.Context, p0 *planetscale.ResizePostgresBouncerRequest) (*planetscale.PostgresBouncerResizeRequest, error) {
+	m.record("Resize", p0)
+	if m.ResizeFunc == nil {
+		return nil, notConfigured("PostgresBouncersService", "Resize")
+	}
+	return m.ResizeFunc(ctx, p0)
+}
+
+// WaitForResize records the call and calls WaitForResizeFunc.
+func (m *PostgresBouncersService) WaitForResize(ctx context.Context, p0 *planetscale.WaitForPostgresBouncerResizeRequest, p1 ...planetscale.WaitOption) (*planetscale.PostgresBouncerResizeRequest, error) {
+	m.record("WaitForResize", p0, p1)
+	if m.WaitForResizeFunc == nil {
+		return nil, notConfigured("PostgresBouncersService", "WaitForResize")
+	}
+	return m.WaitForResizeFunc(ctx, p0, p1...)
+}
+
+// PostgresCIDRsService is a mock implementation of planetscale.PostgresCIDRsService.
+type PostgresCIDRsService struct {
+	Recorder
+
+	AllFunc    func(context.Context, *planetscale.ListPostgresCIDRsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresCIDR, error]
+	CreateFunc func(context.Context, *planetscale.CreatePostgresCIDRRequest) (*planetscale.PostgresCIDR, error)
+	DeleteFunc func(context.Context, *planetscale.DeletePostgresCIDRRequest) error
+	GetFunc    func(context.Context, *planetscale.GetPostgresCIDRRequest) (*planetscale.PostgresCIDR, error)
+	ListFunc   func(context.Context, *planetscale.ListPostgresCIDRsRequest, ...planetscale.ListOption) ([]*planetscale.PostgresCIDR, error)
+	UpdateFunc func(context.Context, *planetscale.UpdatePostgresCIDRRequest) (*planetscale.PostgresCIDR, error)
+}
+
+var _ planetscale.PostgresCIDRsService = (*PostgresCIDRsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *PostgresCIDRsService) All(ctx context.Context, p0 *planetscale.ListPostgresCIDRsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresCIDR, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.PostgresCIDR](notConfigured("PostgresCIDRsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *PostgresCIDRsService) Create(ctx context.Context, p0 *planetscale.CreatePostgresCIDRRequest) (*planetscale.PostgresCIDR, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("PostgresCIDRsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PostgresCIDRsService) Delete(ctx context.Context, p0 *planetscale.DeletePostgresCIDRRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("PostgresCIDRsService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *PostgresCIDRsService) Get(ctx context.Context, p0 *planetscale.GetPostgresCIDRRequest) (*planetscale.PostgresCIDR, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("PostgresCIDRsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *PostgresCIDRsService) List(ctx context.Context, p0 *planetscale.ListPostgresCIDRsRequest, p1 ...planetscale.ListOption) ([]*planetscale.PostgresCIDR, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("PostgresCIDRsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *PostgresCIDRsService) Update(ctx context.Context, p0 *planetscale.UpdatePostgresCIDRRequest) (*planetscale.PostgresCIDR, error) {
+	m.record("Update", p0)
+	if m.UpdateFunc == nil {
+		return nil, notConfigured("PostgresCIDRsService", "Update")
+	}
+	return m.UpdateFunc(ctx, p0)
+}
+
+// PostgresRolesService is a mock implementation of planetscale.PostgresRolesService.
+type PostgresRolesService struct {
+	Recorder
+
+	AllFunc              func(context.Context, *planetscale.ListPostgresRolesRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresRole, error]
+	CreateFunc           func(context.Context, *planetscale.CreatePostgresRoleRequest) (*planetscale.PostgresRole, error)
+	DeleteFunc           func(context.Context, *planetscale.DeletePostgresRoleRequest) error
+	GetFunc              func(context.Context, *planetscale.GetPostgresRoleRequest) (*planetscale.PostgresRole, error)
+	ListFunc             func(context.Context, *planetscale.ListPostgresRolesRequest, ...planetscale.ListOption) ([]*planetscale.PostgresRole, error)
+	ReassignObjectsFunc  func(context.Context, *planetscale.ReassignPostgresRoleObjectsRequest) error
+	RenewFunc            func(context.Context, *planetscale.RenewPostgresRoleRequest) (*planetscale.PostgresRole, error)
+	ResetDefaultRoleFunc func(context.Context, *planetscale.ResetDefaultRoleRequest) (*planetscale.PostgresRole, error)
+	ResetPasswordFunc    func(context.Context, *planetscale.ResetPostgresRolePasswordRequest) (*planetscale.PostgresRole, error)
+	UpdateFunc           func(context.Context, *planetscale.UpdatePostgresRoleRequest) (*planetscale.PostgresRole, error)
+}
+
+var _ planetscale.PostgresRolesService = (*PostgresRolesService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *PostgresRolesService) All(ctx context.Context, p0 *planetscale.ListPostgresRolesRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.PostgresRole, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.PostgresRole](notConfigured("PostgresRolesService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *PostgresRolesService) Create(ctx context.Context, p0 *planetscale.CreatePostgresRoleRequest) (*planetscale.PostgresRole, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PostgresRolesService) Delete(ctx context.Context, p0 *planetscale.DeletePostgresRoleRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("PostgresRolesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *PostgresRolesService) Get(ctx context.Context, p0 *planetscale.GetPostgresRoleRequest) (*planetscale.PostgresRole, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *PostgresRolesService) List(ctx context.Context, p0 *planetscale.ListPostgresRolesRequest, p1 ...planetscale.ListOption) ([]*planetscale.PostgresRole, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// ReassignObjects records the call and calls ReassignObjectsFunc.
+func (m *PostgresRolesService) ReassignObjects(ctx context.Context, p0 *planetscale.ReassignPostgresRoleObjectsRequest) error {
+	m.record("ReassignObjects", p0)
+	if m.ReassignObjectsFunc == nil {
+		return notConfigured("PostgresRolesService", "ReassignObjects")
+	}
+	return m.ReassignObjectsFunc(ctx, p0)
+}
+
+// Renew records the call and calls RenewFunc.
+func (m *PostgresRolesService) Renew(ctx context.Context, p0 *planetscale.RenewPostgresRoleRequest) (*planetscale.PostgresRole, error) {
+	m.record("Renew", p0)
+	if m.RenewFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "Renew")
+	}
+	return m.RenewFunc(ctx, p0)
+}
+
+// ResetDefaultRole records the call and calls ResetDefaultRoleFunc.
+func (m *PostgresRolesService) ResetDefaultRole(ctx context.Context, p0 *planetscale.ResetDefaultRoleRequest) (*planetscale.PostgresRole, error) {
+	m.record("ResetDefaultRole", p0)
+	if m.ResetDefaultRoleFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "ResetDefaultRole")
+	}
+	return m.ResetDefaultRoleFunc(ctx, p0)
+}
+
+// ResetPassword records the call and calls ResetPasswordFunc.
+func (m *PostgresRolesService) ResetPassword(ctx context.Context, p0 *planetscale.ResetPostgresRolePasswordRequest) (*planetscale.PostgresRole, error) {
+	m.record("ResetPassword", p0)
+	if m.ResetPasswordFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "ResetPassword")
+	}
+	return m.ResetPasswordFunc(ctx, p0)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *PostgresRolesService) Update(ctx context.Context, p0 *planetscale.UpdatePostgresRoleRequest) (*planetscale.PostgresRole, error) {
+	m.record("Update", p0)
+	if m.UpdateFunc == nil {
+		return nil, notConfigured("PostgresRolesService", "Update")
+	}
+	return m.UpdateFunc(ctx, p0)
+}
+
+// ProcesslistService is a mock implementation of planetscale.ProcesslistService.
+type ProcesslistService struct {
+	Recorder
+
+	KillFunc func(context.Context, *planetscale.KillProcessRequest) (*planetscale.KillProcessResult, error)
+	ListFunc func(context.Context, *planetscale.ProcesslistRequest) (*planetscale.ProcesslistResult, error)
+}
+
+var _ planetscale.ProcesslistService = (*ProcesslistService)(nil)
+
+// Kill records the call and calls KillFunc.
+func (m *ProcesslistService) Kill(ctx context.Context, p0 *planetscale.KillProcessRequest) (*planetscale.KillProcessResult, error) {
+	m.record("Kill", p0)
+	if m.KillFunc == nil {
+		return nil, notConfigured("ProcesslistService", "Kill")
+	}
+	return m.KillFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *ProcesslistService) List(ctx context.Context, p0 *planetscale.ProcesslistRequest) (*planetscale.ProcesslistResult, error) {
+	m.record("List", p0)
+	if m.ListFunc == nil {
+		return nil, notConfigured("ProcesslistService", "List")
+	}
+	return m.ListFunc(ctx, p0)
+}
+
+// QueryInsightsService is a mock implementation of planetscale.QueryInsightsService.
+type QueryInsightsService struct {
+	Recorder
+
+	GetTagFunc           func(context.Context, *planetscale.GetQueryTagRequest, ...planetscale.ListOption) (*planetscale.QueryTag, error)
+	ListAnomaliesFunc    func(context.Context, *planetscale.ListAnomaliesRequest, ...planetscale.ListOption) ([]*planetscale.Anomaly, error)
+	ListErrorsFunc       func(context.Context, *planetscale.ListQueryInsightsErrorsRequest, ...planetscale.ListOption) ([]*planetscale.QueryInsightError, error)
+	ListQueriesFunc      func(context.Context, *planetscale.ListQueryInsightsRequest, ...planetscale.ListOption) ([]*planetscale.QueryInsight, error)
+	ListQuerySamplesFunc func(context.Context, *planetscale.ListQuerySamplesRequest, ...planetscale.ListOption) ([]*planetscale.QuerySample, error)
+	ListTagSummariesFunc func(context.Context, *planetscale.ListTagSummariesRequest, ...planetscale.ListOption) ([]*planetscale.TagSummary, error)
+	ListTagsFunc         func(context.Context, *planetscale.ListQueryTagsRequest, ...planetscale.ListOption) ([]*planetscale.QueryTag, error)
+}
+
+var _ planetscale.QueryInsightsService = (*QueryInsightsService)(nil)
+
+// GetTag records the call and calls GetTagFunc.
+func (m *QueryInsightsService) GetTag(ctx context.Context, p0 *planetscale.GetQueryTagRequest, p1 ...planetscale.ListOption) (*planetscale.QueryTag, error) {
+	m.record("GetTag", p0, p1)
+	if m.GetTagFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "GetTag")
+	}
+	return m.GetTagFunc(ctx, p0, p1...)
+}
+
+// ListAnomalies records the call and calls ListAnomaliesFunc.
+func (m *QueryInsightsService) ListAnomalies(ctx context.Context, p0 *planetscale.ListAnomaliesRequest, p1 ...planetscale.ListOption) ([]*planetscale.Anomaly, error) {
+	m.record("ListAnomalies", p0, p1)
+	if m.ListAnomaliesFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "ListAnomalies")
+	}
+	return m.ListAnomaliesFunc(ctx, p0, p1...)
+}
+
+// ListErrors records the call and calls ListErrorsFunc.
+func (m *QueryInsightsService) ListErrors(ctx context.Context, p0 *planetscale.ListQueryInsightsErrorsRequest, p1 ...planetscale.ListOption) ([]*planetscale.QueryInsightError, error) {
+	m.record("ListErrors", p0, p1)
+	if m.ListErrorsFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "ListErrors")
+	}
+	return m.ListErrorsFunc(ctx, p0, p1...)
+}
+
+// ListQueries records the call and calls ListQueriesFunc.
+func (m *QueryInsightsService) ListQueries(ctx context.Context, p0 *planetscale.ListQueryInsightsRequest, p1 ...planetscale.ListOption) ([]*planetscale.QueryInsight, error) {
+	m.record("ListQueries", p0, p1)
+	if m.ListQueriesFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "ListQueries")
+	}
+	return m.ListQueriesFunc(ctx, p0, p1...)
+}
+
+// ListQuerySamples records the call and calls ListQuerySamplesFunc.
+func (m *QueryInsightsService) ListQuerySamples(ctx context.Context, p0 *planetscale.ListQuerySamplesRequest, p1 ...planetscale.ListOption) ([]*planetscale.QuerySample, error) {
+	m.record("ListQuerySamples", p0, p1)
+	if m.ListQuerySamplesFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "ListQuerySamples")
+	}
+	return m.ListQuerySamplesFunc(ctx, p0, p1...)
+}
+
+// ListTagSummaries records the call and calls ListTagSummariesFunc.
+func (m *QueryInsightsService) ListTagSummaries(ctx context.Context, p0 *planetscale.ListTagSummariesRequest, p1 ...planetscale.ListOption) ([]*planetscale.TagSummary, error) {
+	m.record("ListTagSummaries", p0, p1)
+	if m.ListTagSummariesFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "ListTagSummaries")
+	}
+	return m.ListTagSummariesFunc(ctx, p0, p1...)
+}
+
+// ListTags records the call and calls ListTagsFunc.
+func (m *QueryInsightsService) ListTags(ctx context.Context, p0 *planetscale.ListQueryTagsRequest, p1 ...planetscale.ListOption) ([]*planetscale.QueryTag, error) {
+	m.record("ListTags", p0, p1)
+	if m.ListTagsFunc == nil {
+		return nil, notConfigured("QueryInsightsService", "ListTags")
+	}
+	return m.ListTagsFunc(ctx, p0, p1...)
+}
+
+// QueryPatternsService is a mock implementation of planetscale.QueryPatternsService.
+type QueryPatternsService struct {
+	Recorder
+
+	CreateReportFunc   func(context.Context, *planetscale.CreateQueryPatternsReportRequest) (*planetscale.QueryPatternsReport, error)
+	DownloadReportFunc func(context.Context, *planetscale.DownloadQueryPatternsReportRequest) (io.ReadCloser, error)
+	GetReportFunc      func(context.Context, *planetscale.GetQueryPatternsReportRequest) (*planetscale.QueryPatternsReport, error)
+}
+
+var _ planetscale.QueryPatternsService = (*QueryPatternsService)(nil)
+
+// CreateReport records the call and calls CreateReportFunc.
+func (m *QueryPatternsService) CreateReport(ctx context.Context, p0 *planetscale.CreateQueryPatternsReportRequest) (*planetscale.QueryPatternsReport, error) {
+	m.record("CreateReport", p0)
+	if m.CreateReportFunc == nil {
+		return nil, notConfigured("QueryPatternsService", "CreateReport")
+	}
+	return m.CreateReportFunc(ctx, p0)
+}
+
+// DownloadReport records the call and calls DownloadReportFunc.
+func (m *QueryPatternsService) DownloadReport(ctx context.Context, p0 *planetscale.DownloadQueryPatternsReportRequest) (io.ReadCloser, error) {
+	m.record("DownloadReport", p0)
+	if m.DownloadReportFunc == nil {
+		return zero[io.ReadCloser](), notConfigured("QueryPatternsService", "DownloadReport")
+	}
+	return m.DownloadReportFunc(ctx, p0)
+}
+
+// GetReport records the call and calls GetReportFunc.
+func (m *QueryPatternsService) GetReport(ctx context.Context, p0 *planetscale.GetQueryPatternsReportRequest) (*planetscale.QueryPatternsReport, error) {
+	m.record("GetReport", p0)
+	if m.GetReportFunc == nil {
+		return nil, notConfigured("QueryPatternsService", "GetReport")
+	}
+	return m.GetReportFunc(ctx, p0)
+}
+
+// ReadOnlyRegionsService is a mock implementation of planetscale.ReadOnlyRegionsService.
+type ReadOnlyRegionsService struct {
+	Recorder
+
+	AllFunc  func(context.Context, *planetscale.ListReadOnlyRegionsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.ReadOnlyRegion, error]
+	ListFunc func(context.Context, *planetscale.ListReadOnlyRegionsRequest, ...planetscale.ListOption) ([]*planetscale.ReadOnlyRegion, error)
+}
+
+var _ planetscale.ReadOnlyRegionsService = (*ReadOnlyRegionsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *ReadOnlyRegionsService) All(ctx context.Context, p0 *planetscale.ListReadOnlyRegionsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.ReadOnlyRegion, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.ReadOnlyRegion](notConfigured("ReadOnlyRegionsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// List records the call and calls ListFunc.
+func (m *ReadOnlyRegionsService) List(ctx context.Context, p0 *planetscale.ListReadOnlyRegionsRequest, p1 ...planetscale.ListOption) ([]*planetscale.ReadOnlyRegion, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("ReadOnlyRegionsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// RegionsService is a mock implementation of planetscale.RegionsService.
+type RegionsService struct {
+	Recorder
+
+	ListFunc func(context.Context, *planetscale.ListRegionsRequest) ([]*planetscale.Region, error)
+}
+
+var _ planetscale.RegionsService = (*RegionsService)(nil)
+
+// List records the call and calls ListFunc.
+func (m *RegionsService) List(ctx context.Context, p0 *planetscale.ListRegionsRequest) ([]*planetscale.Region, error) {
+	m.record("List", p0)
+	if m.ListFunc == nil {
+		return nil, notConfigured("RegionsService", "List")
+	}
+	return m.ListFunc(ctx, p0)
+}
+
+// SchemaRecommendationService is a mock implementation of planetscale.SchemaRecommendationService.
+type SchemaRecommendationService struct {
+	Recorder
+
+	AllFunc     func(context.Context, *planetscale.ListSchemaRecommendationsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.SchemaRecommendation, error]
+	DismissFunc func(context.Context, *planetscale.DismissSchemaRecommendationRequest) (*planetscale.SchemaRecommendation, error)
+	GetFunc     func(context.Context, *planetscale.GetSchemaRecommendationRequest) (*planetscale.SchemaRecommendation, error)
+	ListFunc    func(context.Context, *planetscale.ListSchemaRecommendationsRequest, ...planetscale.ListOption) ([]*planetscale.SchemaRecommendation, error)
+}
+
+var _ planetscale.SchemaRecommendationService = (*SchemaRecommendationService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *SchemaRecommendationService) All(ctx context.Context, p0 *planetscale.ListSchemaRecommendationsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.SchemaRecommendation, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.SchemaRecommendation](notConfigured("SchemaRecommendationService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Dismiss records the call and calls DismissFunc.
+func (m *SchemaRecommendationService) Dismiss(ctx context.Context, p0 *planetscale.DismissSchemaRecommendationRequest) (*planetscale.SchemaRecommendation, error) {
+	m.record("Dismiss", p0)
+	if m.DismissFunc == nil {
+		return nil, notConfigured("SchemaRecommendationService", "Dismiss")
+	}
+	return m.DismissFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *SchemaRecommendationService) Get(ctx context.Context, p0 *planetscale.GetSchemaRecommendationRequest) (*planetscale.SchemaRecommendation, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("SchemaRecommendationService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *SchemaRecommendationService) List(ctx context.Context, p0 *planetscale.ListSchemaRecommendationsRequest, p1 ...planetscale.ListOption) ([]*planetscale.SchemaRecommendation, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("SchemaRecommendationService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// ServiceTokenService is a mock implementation of planetscale.ServiceTokenService.
+type ServiceTokenService struct {
+	Recorder
+
+	AddAccessFunc    func(context.Context, *planetscale.AddServiceTokenAccessRequest) ([]*planetscale.ServiceTokenAccess, error)
+	AllFunc          func(context.Context, *planetscale.ListServiceTokensRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.ServiceToken, error]
+	CreateFunc       func(context.Context, *planetscale.CreateServiceTokenRequest) (*planetscale.ServiceToken, error)
+	DeleteFunc       func(context.Context, *planetscale.DeleteServiceTokenRequest) error
+	DeleteAccessFunc func(context.Context, *planetscale.DeleteServiceTokenAccessRequest) error
+	GetAccessFunc    func(context.Context, *planetscale.GetServiceTokenAccessRequest) ([]*planetscale.ServiceTokenAccess, error)
+	ListFunc         func(context.Context, *planetscale.ListServiceTokensRequest, ...planetscale.ListOption) ([]*planetscale.ServiceToken, error)
+	ListGrantsFunc   func(context.Context, *planetscale.ListServiceTokenGrantsRequest) ([]*planetscale.ServiceTokenGrant, error)
+}
+
+var _ planetscale.ServiceTokenService = (*ServiceTokenService)(nil)
+
+// AddAccess records the call and calls AddAccessFunc.
+func (m *ServiceTokenService) AddAccess(ctx context.Context, p0 *planetscale.AddServiceTokenAccessRequest) ([]*planetscale.ServiceTokenAccess, error) {
+	m.record("AddAccess", p0)
+	if m.AddAccessFunc == nil {
+		return nil, notConfigured("ServiceTokenService", "AddAccess")
+	}
+	return m.AddAccessFunc(ctx, p0)
+}
+
+// All records the call and calls AllFunc.
+func (m *ServiceTokenService) All(ctx context.Context, p0 *planetscale.ListServiceTokensRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.ServiceToken, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.ServiceToken](notConfigured("ServiceTokenService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *ServiceTokenService) Create(ctx context.Context, p0 *planetscale.CreateServiceTokenRequest) (*planetscale.ServiceToken, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("ServiceTokenService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *ServiceTokenService) Delete(ctx context.Context, p0 *planetscale.DeleteServiceTokenRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("ServiceTokenService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// DeleteAccess records the call and calls DeleteAccessFunc.
+func (m *ServiceTokenService) DeleteAccess(ctx context.Context, p0 *planetscale.DeleteServiceTokenAccessRequest) error {
+	m.record("DeleteAccess", p0)
+	if m.DeleteAccessFunc == nil {
+		return notConfigured("ServiceTokenService", "DeleteAccess")
+	}
+	return m.DeleteAccessFunc(ctx, p0)
+}
+
+// GetAccess records the call and calls GetAccessFunc.
+func (m *ServiceTokenService) GetAccess(ctx context.Context, p0 *planetscale.GetServiceTokenAccessRequest) ([]*planetscale.ServiceTokenAccess, error) {
+	m.record("GetAccess", p0)
+	if m.GetAccessFunc == nil {
+		return nil, notConfigured("ServiceTokenService", "GetAccess")
+	}
+	return m.GetAccessFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *ServiceTokenService) List(ctx context.Context, p0 *planetscale.ListServiceTokensRequest, p1 ...planetscale.ListOption) ([]*planetscale.ServiceToken, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("ServiceTokenService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// ListGrants records the call and calls ListGrantsFunc.
+func (m *ServiceTokenService) ListGrants(ctx context.Context, p0 *planetscale.ListServiceTokenGrantsRequest) ([]*planetscale.ServiceTokenGrant, error) {
+	m.record("ListGrants", p0)
+	if m.ListGrantsFunc == nil {
+		return nil, notConfigured("ServiceTokenService", "ListGrants")
+	}
+	return m.ListGrantsFunc(ctx, p0)
+}
+
+// TrafficBudgetsService is a mock implementation of planetscale.TrafficBudgetsService.
+type TrafficBudgetsService struct {
+	Recorder
+
+	AllFunc    func(context.Context, *planetscale.ListTrafficBudgetsRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.TrafficBudget, error]
+	CreateFunc func(context.Context, *planetscale.CreateTrafficBudgetRequest) (*planetscale.TrafficBudget, error)
+	DeleteFunc func(context.Context, *planetscale.DeleteTrafficBudgetRequest) error
+	GetFunc    func(context.Context, *planetscale.GetTrafficBudgetRequest) (*planetscale.TrafficBudget, error)
+	ListFunc   func(context.Context, *planetscale.ListTrafficBudgetsRequest, ...planetscale.ListOption) ([]*planetscale.TrafficBudget, error)
+	UpdateFunc func(context.Context, *planetscale.UpdateTrafficBudgetRequest) (*planetscale.TrafficBudget, error)
+}
+
+var _ planetscale.TrafficBudgetsService = (*TrafficBudgetsService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *TrafficBudgetsService) All(ctx context.Context, p0 *planetscale.ListTrafficBudgetsRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.TrafficBudget, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.TrafficBudget](notConfigured("TrafficBudgetsService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *TrafficBudgetsService) Create(ctx context.Context, p0 *planetscale.CreateTrafficBudgetRequest) (*planetscale.TrafficBudget, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("TrafficBudgetsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *TrafficBudgetsService) Delete(ctx context.Context, p0 *planetscale.DeleteTrafficBudgetRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("TrafficBudgetsService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *TrafficBudgetsService) Get(ctx context.Context, p0 *planetscale.GetTrafficBudgetRequest) (*planetscale.TrafficBudget, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("TrafficBudgetsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *TrafficBudgetsService) List(ctx context.Context, p0 *planetscale.ListTrafficBudgetsRequest, p1 ...planetscale.ListOption) ([]*planetscale.TrafficBudget, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("TrafficBudgetsService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *TrafficBudgetsService) Update(ctx context.Context, p0 *planetscale.UpdateTrafficBudgetRequest) (*planetscale.TrafficBudget, error) {
+	m.record("Update", p0)
+	if m.UpdateFunc == nil {
+		return nil, notConfigured("TrafficBudgetsService", "Update")
+	}
+	return m.UpdateFunc(ctx, p0)
+}
+
+// TrafficRulesService is a mock implementation of planetscale.TrafficRulesService.
+type TrafficRulesService struct {
+	Recorder
+
+	CreateFunc func(context.Context, *planetscale.CreateTrafficRuleRequest) (*planetscale.TrafficRule, error)
+	DeleteFunc func(context.Context, *planetscale.DeleteTrafficRuleRequest) error
+}
+
+var _ planetscale.TrafficRulesService = (*TrafficRulesService)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *TrafficRulesService) Create(ctx context.Context, p0 *planetscale.CreateTrafficRuleRequest) (*planetscale.TrafficRule, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("TrafficRulesService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *TrafficRulesService) Delete(ctx context.Context, p0 *planetscale.DeleteTrafficRuleRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("TrafficRulesService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// VDiffService is a mock implementation of planetscale.VDiffService.
+type VDiffService struct {
+	Recorder
+
+	CreateFunc func(context.Context, *planetscale.VDiffCreateRequest) (json.RawMessage, error)
+	DeleteFunc func(context.Context, *planetscale.VDiffDeleteRequest) (json.RawMessage, error)
+	ListFunc   func(context.Context, *planetscale.VDiffListRequest) (json.RawMessage, error)
+	ResumeFunc func(context.Context, *planetscale.VDiffResumeRequest) (json.RawMessage, error)
+	ShowFunc   func(context.Context, *planetscale.VDiffShowRequest) (json.RawMessage, error)
+	StopFunc   func(context.Context, *planetscale.VDiffStopRequest) (json.RawMessage, error)
+}
+
+var _ planetscale.VDiffService = (*VDiffService)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *VDiffService) Create(ctx context.Context, p0 *planetscale.VDiffCreateRequest) (json.RawMessage, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VDiffService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *VDiffService) Delete(ctx context.Context, p0 *planetscale.VDiffDeleteRequest) (json.RawMessage, error) {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VDiffService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *VDiffService) List(ctx context.Context, p0 *planetscale.VDiffListRequest) (json.RawMessage, error) {
+	m.record("List", p0)
+	if m.ListFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VDiffService", "List")
+	}
+	return m.ListFunc(ctx, p0)
+}
+
+// Resume records the call and calls ResumeFunc.
+func (m *VDiffService) Resume(ctx context.Context, p0 *planetscale.VDiffResumeRequest) (json.RawMessage, error) {
+	m.record("Resume", p0)
+	if m.ResumeFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VDiffService", "Resume")
+	}
+	return m.ResumeFunc(ctx, p0)
+}
+
+// Show records the call and calls ShowFunc.
+func (m *VDiffService) Show(ctx context.Context, p0 *planetscale.VDiffShowRequest) (json.RawMessage, error) {
+	m.record("Show", p0)
+	if m.ShowFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VDiffService", "Show")
+	}
+	return m.ShowFunc(ctx, p0)
+}
+
+// Stop records the call and calls StopFunc.
+func (m *VDiffService) Stop(ctx context.Context, p0 *planetscale.VDiffStopRequest) (json.RawMessage, error) {
+	m.record("Stop", p0)
+	if m.StopFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VDiffService", "Stop")
+	}
+	return m.StopFunc(ctx, p0)
+}
+
+// VtctldService is a mock implementation of planetscale.VtctldService.
+type VtctldService struct {
+	Recorder
+
+	ApplyKeyspaceRoutingRulesFunc func(context.Context, *planetscale.VtctldApplyKeyspaceRoutingRulesRequest) (json.RawMessage, error)
+	CheckThrottlerFunc            func(context.Context, *planetscale.VtctldCheckThrottlerRequest) (json.RawMessage, error)
+	GetKeyspaceRoutingRulesFunc   func(context.Context, *planetscale.VtctldGetKeyspaceRoutingRulesRequest) (json.RawMessage, error)
+	GetOperationFunc              func(context.Context, *planetscale.GetVtctldOperationRequest) (*planetscale.VtctldOperation, error)
+	GetRoutingRulesFunc           func(context.Context, *planetscale.VtctldGetRoutingRulesRequest) (json.RawMessage, error)
+	GetShardFunc                  func(context.Context, *planetscale.VtctldGetShardRequest) (json.RawMessage, error)
+	GetThrottlerStatusFunc        func(context.Context, *planetscale.VtctldGetThrottlerStatusRequest) (json.RawMessage, error)
+	ListKeyspacesFunc             func(context.Context, *planetscale.VtctldListKeyspacesRequest) (json.RawMessage, error)
+	ListTabletsFunc               func(context.Context, *planetscale.ListBranchTabletsRequest) ([]*planetscale.TabletGroup, error)
+	ListWorkflowsFunc             func(context.Context, *planetscale.VtctldListWorkflowsRequest) (json.RawMessage, error)
+	RefreshStateByShardFunc       func(context.Context, *planetscale.VtctldRefreshStateByShardRequest) (json.RawMessage, error)
+	SetShardTabletControlFunc     func(context.Context, *planetscale.VtctldSetShardTabletControlRequest) (json.RawMessage, error)
+	StartWorkflowFunc             func(context.Context, *planetscale.VtctldStartWorkflowRequest) (json.RawMessage, error)
+	StopWorkflowFunc              func(context.Context, *planetscale.VtctldStopWorkflowRequest) (json.RawMessage, error)
+	UpdateThrottlerConfigFunc     func(context.Context, *planetscale.VtctldUpdateThrottlerConfigRequest) (json.RawMessage, error)
+	WaitOperationFunc             func(context.Context, *planetscale.WaitVtctldOperationRequest, ...planetscale.WaitOption) (*planetscale.VtctldOperation, error)
+}
+
+var _ planetscale.VtctldService = (*VtctldService)(nil)
+
+// ApplyKeyspaceRoutingRules records the call and calls ApplyKeyspaceRoutingRulesFunc.
+func (m *VtctldService) ApplyKeyspaceRoutingRules(ctx context.Context, p0 *planetscale.VtctldApplyKeyspaceRoutingRulesRequest) (json.RawMessage, error) {
+	m.record("ApplyKeyspaceRoutingRules", p0)
+	if m.ApplyKeyspaceRoutingRulesFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "ApplyKeyspaceRoutingRules")
+	}
+	return m.ApplyKeyspaceRoutingRulesFunc(ctx, p0)
+}
+
+// CheckThrottler records the call and calls CheckThrottlerFunc.
+func (m *VtctldService) CheckThrottler(ctx context.Context, p0 *planetscale.VtctldCheckThrottlerRequest) (json.RawMessage, error) {
+	m.record("CheckThrottler", p0)
+	if m.CheckThrottlerFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "CheckThrottler")
+	}
+	return m.CheckThrottlerFunc(ctx, p0)
+}
+
+// GetKeyspaceRoutingRules records the call and calls GetKeyspaceRoutingRulesFunc.
+func (m *VtctldService) GetKeyspaceRoutingRules(ctx context.Context, p0 *planetscale.VtctldGetKeyspaceRoutingRulesRequest) (json.RawMessage, error) {
+	m.record("GetKeyspaceRoutingRules", p0)
+	if m.GetKeyspaceRoutingRulesFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "GetKeyspaceRoutingRules")
+	}
+	return m.GetKeyspaceRoutingRulesFunc(ctx, p0)
+}
+
+// GetOperation records the call and calls GetOperationFunc.
+func (m *VtctldService) GetOperation(ctx context.Context, p0 *planetscale.GetVtctldOperationRequest) (*planetscale.VtctldOperation, error) {
+	m.record("GetOperation", p0)
+	if m.GetOperationFunc == nil {
+		return nil, notConfigured("VtctldService", "GetOperation")
+	}
+	return m.GetOperationFunc(ctx, p0)
+}
+
+// GetRoutingRules records the call and calls GetRoutingRulesFunc.
+func (m *VtctldService) GetRoutingRules(ctx context.Context, p0 *planetscale.VtctldGetRoutingRulesRequest) (json.RawMessage, error) {
+	m.record("GetRoutingRules", p0)
+	if m.GetRoutingRulesFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "GetRoutingRules")
+	}
+	return m.GetRoutingRulesFunc(ctx, p0)
+}
+
+// GetShard records the call and calls GetShardFunc.
+func (m *VtctldService) GetShard(ctx context.Context, p0 *planetscale.VtctldGetShardRequest) (json.RawMessage, error) {
+	m.record("GetShard", p0)
+	if m.GetShardFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "GetShard")
+	}
+	return m.GetShardFunc(ctx, p0)
+}
+
+// GetThrottlerStatus records the call and calls GetThrottlerStatusFunc.
+func (m *VtctldService) GetThrottlerStatus(ctx context.Context, p0 *planetscale.VtctldGetThrottlerStatusRequest) (json.RawMessage, error) {
+	m.record("GetThrottlerStatus", p0)
+	if m.GetThrottlerStatusFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "GetThrottlerStatus")
+	}
+	return m.GetThrottlerStatusFunc(ctx, p0)
+}
+
+// ListKeyspaces records the call and calls ListKeyspacesFunc.
+func (m *VtctldService) ListKeyspaces(ctx context.Context, p0 *planetscale.VtctldListKeyspacesRequest) (json.RawMessage, error) {
+	m.record("ListKeyspaces", p0)
+	if m.ListKeyspacesFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "ListKeyspaces")
+	}
+	return m.ListKeyspacesFunc(ctx, p0)
+}
+
+// ListTablets records the call and calls ListTabletsFunc.
+func (m *VtctldService) ListTablets(ctx context.Context, p0 *planetscale.ListBranchTabletsRequest) ([]*planetscale.TabletGroup, error) {
+	m.record("ListTablets", p0)
+	if m.ListTabletsFunc == nil {
+		return nil, notConfigured("VtctldService", "ListTablets")
+	}
+	return m.ListTabletsFunc(ctx, p0)
+}
+
+// ListWorkflows records the call and calls ListWorkflowsFunc.
+func (m *VtctldService) ListWorkflows(ctx context.Context, p0 *planetscale.VtctldListWorkflowsRequest) (json.RawMessage, error) {
+	m.record("ListWorkflows", p0)
+	if m.ListWorkflowsFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "ListWorkflows")
+	}
+	return m.ListWorkflowsFunc(ctx, p0)
+}
+
+// RefreshStateByShard records the call and calls RefreshStateByShardFunc.
+func (m *VtctldService) RefreshStateByShard(ctx context.Context, p0 *planetscale.VtctldRefreshStateByShardRequest) (json.RawMessage, error) {
+	m.record("RefreshStateByShard", p0)
+	if m.RefreshStateByShardFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "RefreshStateByShard")
+	}
+	return m.RefreshStateByShardFunc(ctx, p0)
+}
+
+// SetShardTabletControl records the call and calls SetShardTabletControlFunc.
+func (m *VtctldService) SetShardTabletControl(ctx context.Context, p0 *planetscale.VtctldSetShardTabletControlRequest) (json.RawMessage, error) {
+	m.record("SetShardTabletControl", p0)
+	if m.SetShardTabletControlFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "SetShardTabletControl")
+	}
+	return m.SetShardTabletControlFunc(ctx, p0)
+}
+
+// StartWorkflow records the call and calls StartWorkflowFunc.
+func (m *VtctldService) StartWorkflow(ctx context.Context, p0 *planetscale.VtctldStartWorkflowRequest) (json.RawMessage, error) {
+	m.record("StartWorkflow", p0)
+	if m.StartWorkflowFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "StartWorkflow")
+	}
+	return m.StartWorkflowFunc(ctx, p0)
+}
+
+// StopWorkflow records the call and calls StopWorkflowFunc.
+func (m *VtctldService) StopWorkflow(ctx context.Context, p0 *planetscale.VtctldStopWorkflowRequest) (json.RawMessage, error) {
+	m.record("StopWorkflow", p0)
+	if m.StopWorkflowFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "StopWorkflow")
+	}
+	return m.StopWorkflowFunc(ctx, p0)
+}
+
+// UpdateThrottlerConfig records the call and calls UpdateThrottlerConfigFunc.
+func (m *VtctldService) UpdateThrottlerConfig(ctx context.Context, p0 *planetscale.VtctldUpdateThrottlerConfigRequest) (json.RawMessage, error) {
+	m.record("UpdateThrottlerConfig", p0)
+	if m.UpdateThrottlerConfigFunc == nil {
+		return zero[json.RawMessage](), notConfigured("VtctldService", "UpdateThrottlerConfig")
+	}
+	return m.UpdateThrottlerConfigFunc(ctx, p0)
+}
+
+// WaitOperation records the call and calls WaitOperationFunc.
+func (m *VtctldService) WaitOperation(ctx context.Context, p0 *planetscale.WaitVtctldOperationRequest, p1 ...planetscale.WaitOption) (*planetscale.VtctldOperation, error) {
+	m.record("WaitOperation", p0, p1)
+	if m.WaitOperationFunc == nil {
+		return nil, notConfigured("VtctldService", "WaitOperation")
+	}
+	return m.WaitOperationFunc(ctx, p0, p1...)
+}
+
+// WebhooksService is a mock implementation of planetscale.WebhooksService.
+type WebhooksService struct {
+	Recorder
+
+	AllFunc    func(context.Context, *planetscale.ListWebhooksRequest, ...planetscale.ListOption) iter.Seq2[*planetscale.Webhook, error]
+	CreateFunc func(context.Context, *planetscale.CreateWebhookRequest) (*planetscale.Webhook, error)
+	DeleteFunc func(context.Context, *planetscale.DeleteWebhookRequest) error
+	GetFunc    func(context.Context, *planetscale.GetWebhookRequest) (*planetscale.Webhook, error)
+	ListFunc   func(context.Context, *planetscale.ListWebhooksRequest, ...planetscale.ListOption) ([]*planetscale.Webhook, error)
+	TestFunc   func(context.Context, *planetscale.TestWebhookRequest) error
+	UpdateFunc func(context.Context, *planetscale.UpdateWebhookRequest) (*planetscale.Webhook, error)
+}
+
+var _ planetscale.WebhooksService = (*WebhooksService)(nil)
+
+// All records the call and calls AllFunc.
+func (m *WebhooksService) All(ctx context.Context, p0 *planetscale.ListWebhooksRequest, p1 ...planetscale.ListOption) iter.Seq2[*planetscale.Webhook, error] {
+	m.record("All", p0, p1)
+	if m.AllFunc == nil {
+		return notConfiguredSeq2[*planetscale.Webhook](notConfigured("WebhooksService", "All"))
+	}
+	return m.AllFunc(ctx, p0, p1...)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *WebhooksService) Create(ctx context.Context, p0 *planetscale.CreateWebhookRequest) (*planetscale.Webhook, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("WebhooksService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *WebhooksService) Delete(ctx context.Context, p0 *planetscale.DeleteWebhookRequest) error {
+	m.record("Delete", p0)
+	if m.DeleteFunc == nil {
+		return notConfigured("WebhooksService", "Delete")
+	}
+	return m.DeleteFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *WebhooksService) Get(ctx context.Context, p0 *planetscale.GetWebhookRequest) (*planetscale.Webhook, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("WebhooksService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *WebhooksService) List(ctx context.Context, p0 *planetscale.ListWebhooksRequest, p1 ...planetscale.ListOption) ([]*planetscale.Webhook, error) {
+	m.record("List", p0, p1)
+	if m.ListFunc == nil {
+		return nil, notConfigured("WebhooksService", "List")
+	}
+	return m.ListFunc(ctx, p0, p1...)
+}
+
+// Test records the call and calls TestFunc.
+func (m *WebhooksService) Test(ctx context.Context, p0 *planetscale.TestWebhookRequest) error {
+	m.record("Test", p0)
+	if m.TestFunc == nil {
+		return notConfigured("WebhooksService", "Test")
+	}
+	return m.TestFunc(ctx, p0)
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *WebhooksService) Update(ctx context.Context, p0 *planetscale.UpdateWebhookRequest) (*planetscale.Webhook, error) {
+	m.record("Update", p0)
+	if m.UpdateFunc == nil {
+		return nil, notConfigured("WebhooksService", "Update")
+	}
+	return m.UpdateFunc(ctx, p0)
+}
+
+// WorkflowsService is a mock implementation of planetscale.WorkflowsService.
+type WorkflowsService struct {
+	Recorder
+
+	CancelFunc          func(context.Context, *planetscale.CancelWorkflowRequest) (*planetscale.Workflow, error)
+	CompleteFunc        func(context.Context, *planetscale.CompleteWorkflowRequest) (*planetscale.Workflow, error)
+	CreateFunc          func(context.Context, *planetscale.CreateWorkflowRequest) (*planetscale.Workflow, error)
+	CutoverFunc         func(context.Context, *planetscale.CutoverWorkflowRequest) (*planetscale.Workflow, error)
+	GetFunc             func(context.Context, *planetscale.GetWorkflowRequest) (*planetscale.Workflow, error)
+	ListFunc            func(context.Context, *planetscale.ListWorkflowsRequest) ([]*planetscale.Workflow, error)
+	RetryFunc           func(context.Context, *planetscale.RetryWorkflowRequest) (*planetscale.Workflow, error)
+	ReverseCutoverFunc  func(context.Context, *planetscale.ReverseCutoverWorkflowRequest) (*planetscale.Workflow, error)
+	ReverseTrafficFunc  func(context.Context, *planetscale.ReverseTrafficWorkflowRequest) (*planetscale.Workflow, error)
+	SwitchPrimariesFunc func(context.Context, *planetscale.SwitchPrimariesWorkflowRequest) (*planetscale.Workflow, error)
+	SwitchReplicasFunc  func(context.Context, *planetscale.SwitchReplicasWorkflowRequest) (*planetscale.Workflow, error)
+	VerifyDataFunc      func(context.Context, *planetscale.VerifyDataWorkflowRequest) (*planetscale.Workflow, error)
+}
+
+var _ planetscale.WorkflowsService = (*WorkflowsService)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *WorkflowsService) Cancel(ctx context.Context, p0 *planetscale.CancelWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("Cancel", p0)
+	if m.CancelFunc == nil {
+		return nil, notConfigured("WorkflowsService", "Cancel")
+	}
+	return m.CancelFunc(ctx, p0)
+}
+
+// Complete records the call and calls CompleteFunc.
+func (m *WorkflowsService) Complete(ctx context.Context, p0 *planetscale.CompleteWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("Complete", p0)
+	if m.CompleteFunc == nil {
+		return nil, notConfigured("WorkflowsService", "Complete")
+	}
+	return m.CompleteFunc(ctx, p0)
+}
+
+// Create records the call and calls CreateFunc.
+func (m *WorkflowsService) Create(ctx context.Context, p0 *planetscale.CreateWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("Create", p0)
+	if m.CreateFunc == nil {
+		return nil, notConfigured("WorkflowsService", "Create")
+	}
+	return m.CreateFunc(ctx, p0)
+}
+
+// Cutover records the call and calls CutoverFunc.
+func (m *WorkflowsService) Cutover(ctx context.Context, p0 *planetscale.CutoverWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("Cutover", p0)
+	if m.CutoverFunc == nil {
+		return nil, notConfigured("WorkflowsService", "Cutover")
+	}
+	return m.CutoverFunc(ctx, p0)
+}
+
+// Get records the call and calls GetFunc.
+func (m *WorkflowsService) Get(ctx context.Context, p0 *planetscale.GetWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("Get", p0)
+	if m.GetFunc == nil {
+		return nil, notConfigured("WorkflowsService", "Get")
+	}
+	return m.GetFunc(ctx, p0)
+}
+
+// List records the call and calls ListFunc.
+func (m *WorkflowsService) List(ctx context.Context, p0 *planetscale.ListWorkflowsRequest) ([]*planetscale.Workflow, error) {
+	m.record("List", p0)
+	if m.ListFunc == nil {
+		return nil, notConfigured("WorkflowsService", "List")
+	}
+	return m.ListFunc(ctx, p0)
+}
+
+// Retry records the call and calls RetryFunc.
+func (m *WorkflowsService) Retry(ctx context.Context, p0 *planetscale.RetryWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("Retry", p0)
+	if m.RetryFunc == nil {
+		return nil, notConfigured("WorkflowsService", "Retry")
+	}
+	return m.RetryFunc(ctx, p0)
+}
+
+// ReverseCutover records the call and calls ReverseCutoverFunc.
+func (m *WorkflowsService) ReverseCutover(ctx context.Context, p0 *planetscale.ReverseCutoverWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("ReverseCutover", p0)
+	if m.ReverseCutoverFunc == nil {
+		return nil, notConfigured("WorkflowsService", "ReverseCutover")
+	}
+	return m.ReverseCutoverFunc(ctx, p0)
+}
+
+// ReverseTraffic records the call and calls ReverseTrafficFunc.
+func (m *WorkflowsService) ReverseTraffic(ctx context.Context, p0 *planetscale.ReverseTrafficWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("ReverseTraffic", p0)
+	if m.ReverseTrafficFunc == nil {
+		return nil, notConfigured("WorkflowsService", "ReverseTraffic")
+	}
+	return m.ReverseTrafficFunc(ctx, p0)
+}
+
+// SwitchPrimaries records the call and calls SwitchPrimariesFunc.
+func (m *WorkflowsService) SwitchPrimaries(ctx context.Context, p0 *planetscale.SwitchPrimariesWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("SwitchPrimaries", p0)
+	if m.SwitchPrimariesFunc == nil {
+		return nil, notConfigured("WorkflowsService", "SwitchPrimaries")
+	}
+	return m.SwitchPrimariesFunc(ctx, p0)
+}
+
+// SwitchReplicas records the call and calls SwitchReplicasFunc.
+func (m *WorkflowsService) SwitchReplicas(ctx context.Context, p0 *planetscale.SwitchReplicasWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("SwitchReplicas", p0)
+	if m.SwitchReplicasFunc == nil {
+		return nil, notConfigured("WorkflowsService", "SwitchReplicas")
+	}
+	return m.SwitchReplicasFunc(ctx, p0)
+}
+
+// VerifyData records the call and calls VerifyDataFunc.
+func (m *WorkflowsService) VerifyData(ctx context.Context, p0 *planetscale.VerifyDataWorkflowRequest) (*planetscale.Workflow, error) {
+	m.record("VerifyData", p0)
+	if m.VerifyDataFunc == nil {
+		return nil, notConfigured("WorkflowsService", "VerifyData")
+	}
+	return m.VerifyDataFunc(ctx, p0)
+}
@@ -0,0 +1,155 @@
+package planetscaletest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+const (
+	defaultRetentionUnit  = "week"
+	defaultRetentionValue = 1
+
+	// backupSize is the size reported for completed backups.
+	backupSize = 64 << 20
+)
+
+type backup struct {
+	*planetscale.Backup
+}
+
+// advance moves a backup from pending to running to success.
+func (b *backup) advance() {
+	t := now()
+	switch b.State {
+	case planetscale.BackupPending:
+		b.State = planetscale.BackupRunning
+		b.StartedAt = t
+	case planetscale.BackupRunning:
+		b.State = planetscale.BackupSuccess
+		b.Size = backupSize
+		b.CompletedAt = t
+	default:
+		return
+	}
+	b.UpdatedAt = t
+}
+
+func (b *branch) backup(id string) (*backup, bool) {
+	return find(b.backups, func(bk *backup) bool { return bk.PublicID == id })
+}
+
+func (s *Server) registerBackups(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases/{db}/branches/{branch}/backups"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		bk, err := s.createBackup(r)
+		reply(w, http.StatusCreated, bk, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		_, b, err := s.branch(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		backups := make([]*planetscale.Backup, 0, len(b.backups))
+		for _, bk := range b.backups {
+			bk.advance()
+			backups = append(backups, bk.Backup)
+		}
+		reply(w, http.StatusOK, paginate(r, backups), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, bk, err := s.backup(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		bk.advance()
+		reply(w, http.StatusOK, bk.Backup, nil)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		b, bk, err := s.backup(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		b.backups, _ = remove(b.backups, func(other *backup) bool { return other == bk })
+		reply(w, http.StatusNoContent, nil, nil)
+	})
+}
+
+// backup returns the backup identified by the org, db, branch and id path
+// values, along with its branch.
+func (s *Server) backup(r *http.Request) (*branch, *backup, *apiError) {
+	_, b, err := s.branch(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	bk, ok := b.backup(r.PathValue("id"))
+	if !ok {
+		return nil, nil, notFound("backup not found")
+	}
+	return b, bk, nil
+}
+
+func (s *Server) createBackup(r *http.Request) (*planetscale.Backup, *apiError) {
+	_, b, err := s.branch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.CreateBackupRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	unit, value := req.RetentionUnit, req.RetentionValue
+	if unit == "" {
+		unit = defaultRetentionUnit
+	}
+	if value == 0 {
+		value = defaultRetentionValue
+	}
+
+	created := now()
+	expires, ok := addRetention(created, unit, value)
+	if !ok {
+		return nil, unprocessable("unsupported retention unit " + unit)
+	}
+	name := req.Name
+	if name == "" {
+		name = created.Format("2006.01.02 15:04:05")
+	}
+
+	bk := &backup{
+		Backup: &planetscale.Backup{
+			PublicID:  newID(),
+			Name:      name,
+			State:     planetscale.BackupPending,
+			Actor:     &fakeActor,
+			CreatedAt: created,
+			UpdatedAt: created,
+			ExpiresAt: expires,
+		},
+	}
+	b.backups = append(b.backups, bk)
+	return bk.Backup, nil
+}
+
+// addRetention returns t plus value retention units.
+func addRetention(t time.Time, unit string, value int) (time.Time, bool) {
+	switch unit {
+	case "hour":
+		return t.Add(time.Duration(value) * time.Hour), true
+	case "day":
+		return t.AddDate(0, 0, value), true
+	case "week":
+		return t.AddDate(0, 0, 7*value), true
+	case "month":
+		return t.AddDate(0, value, 0), true
+	case "year":
+		return t.AddDate(value, 0, 0), true
+	}
+	return time.Time{}, false
+}
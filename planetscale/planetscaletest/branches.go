@@ -0,0 +1,195 @@
+package planetscaletest
+
+import (
+	"net/http"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+type branch struct {
+	*planetscale.DatabaseBranch
+
+	passwords []*planetscale.DatabaseBranchPassword
+	backups   []*backup
+	keyspaces []*keyspace
+}
+
+func newBranch(db *database, name, parent string, region planetscale.Region) *branch {
+	created := now()
+	b := &branch{
+		DatabaseBranch: &planetscale.DatabaseBranch{
+			ID:           newID(),
+			Name:         name,
+			ParentBranch: parent,
+			Actor:        fakeActor,
+			Region:       region,
+			HtmlURL:      db.HtmlURL + "/" + name,
+			CreatedAt:    created,
+			UpdatedAt:    created,
+		},
+	}
+
+	// Vitess branches come with a keyspace named after the database.
+	if db.Kind == planetscale.DatabaseEngineMySQL {
+		ks := newKeyspace(db.Name, "PS_10", 1, 0)
+		ks.Ready = true
+		b.keyspaces = append(b.keyspaces, ks)
+	}
+	return b
+}
+
+// advance moves a newly created branch to the ready state.
+func (b *branch) advance() {
+	if !b.Ready {
+		b.Ready = true
+		b.UpdatedAt = now()
+	}
+}
+
+func (d *database) branch(name string) (*branch, bool) {
+	return find(d.branches, func(b *branch) bool { return b.Name == name })
+}
+
+func (s *Server) registerBranches(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases/{db}/branches"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		b, err := s.createBranch(r)
+		reply(w, http.StatusCreated, b, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.database(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		branches := make([]*planetscale.DatabaseBranch, 0, len(db.branches))
+		for _, b := range db.branches {
+			b.advance()
+			branches = append(branches, b.DatabaseBranch)
+		}
+		reply(w, http.StatusOK, paginate(r, branches), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{branch}", func(w http.ResponseWriter, r *http.Request) {
+		_, b, err := s.branch(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		b.advance()
+		reply(w, http.StatusOK, b.DatabaseBranch, nil)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{branch}", func(w http.ResponseWriter, r *http.Request) {
+		db, b, err := s.branch(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if b.Name == db.DefaultBranch {
+			writeError(w, unprocessable("the default branch cannot be deleted"))
+			return
+		}
+		db.branches, _ = remove(db.branches, func(other *branch) bool { return other == b })
+		reply(w, http.StatusNoContent, nil, nil)
+	})
+	mux.HandleFunc("POST "+prefix+"/{branch}/promote", func(w http.ResponseWriter, r *http.Request) {
+		b, err := s.updateBranch(r, func(_ *database, b *branch) *apiError {
+			b.Production = true
+			return nil
+		})
+		reply(w, http.StatusOK, b, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{branch}/demote", func(w http.ResponseWriter, r *http.Request) {
+		b, err := s.updateBranch(r, func(db *database, b *branch) *apiError {
+			if b.Name == db.DefaultBranch {
+				return unprocessable("the default branch cannot be demoted")
+			}
+			b.Production = false
+			b.SafeMigrations = false
+			return nil
+		})
+		reply(w, http.StatusOK, b, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{branch}/safe-migrations", func(w http.ResponseWriter, r *http.Request) {
+		b, err := s.updateBranch(r, func(_ *database, b *branch) *apiError {
+			if !b.Production {
+				return unprocessable("safe migrations can only be enabled on production branches")
+			}
+			b.SafeMigrations = true
+			return nil
+		})
+		reply(w, http.StatusOK, b, err)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{branch}/safe-migrations", func(w http.ResponseWriter, r *http.Request) {
+		b, err := s.updateBranch(r, func(_ *database, b *branch) *apiError {
+			b.SafeMigrations = false
+			return nil
+		})
+		reply(w, http.StatusOK, b, err)
+	})
+}
+
+// branch returns the branch named by the org, db and branch path values.
+func (s *Server) branch(r *http.Request) (*database, *branch, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, ok := db.branch(r.PathValue("branch"))
+	if !ok {
+		return nil, nil, notFound("branch not found")
+	}
+	return db, b, nil
+}
+
+func (s *Server) updateBranch(r *http.Request, update func(*database, *branch) *apiError) (*planetscale.DatabaseBranch, *apiError) {
+	db, b, err := s.branch(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(db, b); err != nil {
+		return nil, err
+	}
+	b.UpdatedAt = now()
+	return b.DatabaseBranch, nil
+}
+
+func (s *Server) createBranch(r *http.Request) (*planetscale.DatabaseBranch, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.CreateDatabaseBranchRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, unprocessable("name is required")
+	}
+	if _, ok := db.branch(req.Name); ok {
+		return nil, conflict("a branch named " + req.Name + " already exists")
+	}
+
+	parentName := req.ParentBranch
+	if parentName == "" {
+		parentName = db.DefaultBranch
+	}
+	parent, ok := db.branch(parentName)
+	if !ok {
+		return nil, unprocessable("parent branch " + parentName + " does not exist")
+	}
+	if req.BackupID != "" {
+		if _, ok := parent.backup(req.BackupID); !ok {
+			return nil, unprocessable("backup " + req.BackupID + " does not exist")
+		}
+	}
+
+	region := parent.Region
+	if req.Region != "" {
+		region = regionOrDefault(req.Region)
+	}
+	b := newBranch(db, req.Name, parentName, region)
+	db.branches = append(db.branches, b)
+	return b.DatabaseBranch, nil
+}
@@ -0,0 +1,172 @@
+package planetscaletest
+
+import (
+	"net/http"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+type database struct {
+	*planetscale.Database
+
+	branches          []*branch
+	deployRequests    []*deployRequest
+	webhooks          []*planetscale.Webhook
+	lastDeployRequest uint64
+}
+
+// advance moves a newly created database to the ready state.
+func (d *database) advance() {
+	if d.State == planetscale.DatabasePending {
+		d.State = planetscale.DatabaseReady
+		d.UpdatedAt = now()
+	}
+}
+
+func (s *Server) registerDatabases(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.createDatabase(r)
+		reply(w, http.StatusCreated, db, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		org := s.organization(r.PathValue("org"))
+		dbs := make([]*planetscale.Database, 0, len(org.databases))
+		for _, db := range org.databases {
+			db.advance()
+			dbs = append(dbs, db.Database)
+		}
+		reply(w, http.StatusOK, paginate(r, dbs), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{db}", func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.database(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		db.advance()
+		reply(w, http.StatusOK, db.Database, nil)
+	})
+	mux.HandleFunc("PATCH "+prefix+"/{db}", func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.updateDatabase(r)
+		reply(w, http.StatusOK, db, err)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{db}", func(w http.ResponseWriter, r *http.Request) {
+		org := s.organization(r.PathValue("org"))
+		var ok bool
+		org.databases, ok = remove(org.databases, func(db *database) bool {
+			return db.Name == r.PathValue("db")
+		})
+		if !ok {
+			writeError(w, notFound("database not found"))
+			return
+		}
+		reply(w, http.StatusOK, &planetscale.DatabaseDeletionRequest{ID: newID(), Actor: fakeActor}, nil)
+	})
+}
+
+// database returns the database named by the org and db path values.
+func (s *Server) database(r *http.Request) (*database, *apiError) {
+	org := s.organization(r.PathValue("org"))
+	db, ok := find(org.databases, func(db *database) bool {
+		return db.Name == r.PathValue("db")
+	})
+	if !ok {
+		return nil, notFound("database not found")
+	}
+	return db, nil
+}
+
+func (s *Server) createDatabase(r *http.Request) (*planetscale.Database, *apiError) {
+	var req planetscale.CreateDatabaseRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, unprocessable("name is required")
+	}
+
+	orgName := r.PathValue("org")
+	org := s.organization(orgName)
+	if _, ok := find(org.databases, func(db *database) bool { return db.Name == req.Name }); ok {
+		return nil, conflict("a database named " + req.Name + " already exists")
+	}
+
+	kind := req.Kind
+	if kind == "" {
+		kind = planetscale.DatabaseEngineMySQL
+	}
+	created := now()
+	db := &database{
+		Database: &planetscale.Database{
+			Name:          req.Name,
+			Notes:         req.Notes,
+			Region:        regionOrDefault(req.Region),
+			State:         planetscale.DatabasePending,
+			Kind:          kind,
+			HtmlURL:       "https://app.planetscale.com/" + orgName + "/" + req.Name,
+			DefaultBranch: "main",
+			CreatedAt:     created,
+			UpdatedAt:     created,
+		},
+	}
+
+	main := newBranch(db, "main", "", db.Region)
+	main.Ready = true
+	main.Production = true
+	db.branches = append(db.branches, main)
+
+	org.databases = append(org.databases, db)
+	return db.Database, nil
+}
+
+func (s *Server) updateDatabase(r *http.Request) (*planetscale.Database, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.UpdateDatabaseSettingsRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+
+	if req.NewName != nil && *req.NewName != db.Name {
+		org := s.organization(r.PathValue("org"))
+		if _, ok := find(org.databases, func(other *database) bool { return other.Name == *req.NewName }); ok {
+			return nil, conflict("a database named " + *req.NewName + " already exists")
+		}
+		db.Name = *req.NewName
+	}
+	if req.DefaultBranch != nil {
+		if _, ok := db.branch(*req.DefaultBranch); !ok {
+			return nil, unprocessable("branch " + *req.DefaultBranch + " does not exist")
+		}
+		db.DefaultBranch = *req.DefaultBranch
+	}
+	if req.AutomaticMigrations != nil {
+		db.AutomaticMigrations = req.AutomaticMigrations
+	}
+	if req.MigrationFramework != nil {
+		db.MigrationFramework = req.MigrationFramework
+	}
+	if req.MigrationTableName != nil {
+		db.MigrationTableName = req.MigrationTableName
+	}
+	setBool(&db.RequireApprovalForDeploy, req.RequireApprovalForDeploy)
+	setBool(&db.RestrictBranchRegion, req.RestrictBranchRegion)
+	setBool(&db.AllowDataBranching, req.AllowDataBranching)
+	setBool(&db.ForeignKeysEnabled, req.AllowForeignKeyConstraints)
+	setBool(&db.InsightsRawQueries, req.InsightsRawQueries)
+	setBool(&db.ProductionBranchWebConsole, req.ProductionBranchWebConsole)
+	db.UpdatedAt = now()
+
+	return db.Database, nil
+}
+
+func setBool(dst *bool, src *bool) {
+	if src != nil {
+		*dst = *src
+	}
+}
@@ -0,0 +1,342 @@
+package planetscaletest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+type deployRequest struct {
+	*planetscale.DeployRequest
+
+	autoCutover bool
+}
+
+// deploymentTransitions lists the deployment states a deploy request moves
+// through on its own, mapped to the state that follows them.
+var deploymentTransitions = map[planetscale.DeploymentState]planetscale.DeploymentState{
+	planetscale.DeploymentPending:           planetscale.DeploymentReady,
+	planetscale.DeploymentQueued:            planetscale.DeploymentInProgress,
+	planetscale.DeploymentInProgressCutover: planetscale.DeploymentCompletePendingRevert,
+	planetscale.DeploymentInProgressRevert:  planetscale.DeploymentCompleteRevert,
+	planetscale.DeploymentInProgressCancel:  planetscale.DeploymentCompleteCancel,
+}
+
+// advance moves the deployment of a deploy request one step forward. Deploy
+// requests without auto cutover stop in pending_cutover until ApplyDeploy is
+// called.
+func (d *deployRequest) advance() {
+	state := d.DeploymentState
+	next, ok := deploymentTransitions[state]
+	if state == planetscale.DeploymentInProgress {
+		next, ok = planetscale.DeploymentPendingCutover, true
+		if d.autoCutover {
+			next = planetscale.DeploymentCompletePendingRevert
+		}
+	}
+	if !ok {
+		return
+	}
+
+	d.setDeploymentState(next)
+	t := now()
+	switch next {
+	case planetscale.DeploymentReady:
+		d.Deployment.Deployable = true
+	case planetscale.DeploymentInProgress:
+		d.Deployment.StartedAt = &t
+	case planetscale.DeploymentCompletePendingRevert:
+		d.Deployment.FinishedAt = &t
+		d.DeployedAt = &t
+		d.close()
+	case planetscale.DeploymentCompleteCancel:
+		d.Deployment.FinishedAt = &t
+	}
+}
+
+func (d *deployRequest) setDeploymentState(state planetscale.DeploymentState) {
+	t := now()
+	d.DeploymentState = state
+	d.Deployment.State = state
+	d.Deployment.UpdatedAt = t
+	d.UpdatedAt = t
+}
+
+func (d *deployRequest) close() {
+	t := now()
+	d.State = planetscale.DeployRequestClosed
+	d.ClosedAt = &t
+	d.ClosedBy = &fakeActor
+}
+
+// operations returns the deploy operations of the deployment, or none if it
+// has not been queued yet.
+func (d *deployRequest) operations(keyspace string) []*planetscale.DeployOperation {
+	var state string
+	var progress uint64
+	switch d.DeploymentState {
+	case planetscale.DeploymentPending, planetscale.DeploymentReady, planetscale.DeploymentNoChanges:
+		return []*planetscale.DeployOperation{}
+	case planetscale.DeploymentQueued:
+		state = "pending"
+	case planetscale.DeploymentInProgress, planetscale.DeploymentInProgressCancel:
+		state, progress = "in_progress", 50
+	case planetscale.DeploymentCompleteCancel, planetscale.DeploymentCancelled:
+		state = "cancelled"
+	default:
+		state, progress = "complete", 100
+	}
+
+	return []*planetscale.DeployOperation{{
+		ID:                 d.Deployment.ID,
+		State:              state,
+		Table:              "planetscaletest",
+		Keyspace:           keyspace,
+		Operation:          "ALTER",
+		ProgressPercentage: progress,
+		CreatedAt:          d.Deployment.CreatedAt,
+		UpdatedAt:          d.Deployment.UpdatedAt,
+	}}
+}
+
+func (s *Server) registerDeployRequests(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases/{db}/deploy-requests"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.createDeployRequest(r)
+		reply(w, http.StatusCreated, dr, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.database(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		query := r.URL.Query()
+		drs := make([]*planetscale.DeployRequest, 0, len(db.deployRequests))
+		for _, dr := range db.deployRequests {
+			dr.advance()
+			if (query.Get("state") == "" || query.Get("state") == string(dr.State)) &&
+				(query.Get("branch") == "" || query.Get("branch") == dr.Branch) &&
+				(query.Get("into_branch") == "" || query.Get("into_branch") == dr.IntoBranch) {
+				drs = append(drs, dr.DeployRequest)
+			}
+		}
+		reply(w, http.StatusOK, paginate(r, drs), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{number}", func(w http.ResponseWriter, r *http.Request) {
+		_, dr, err := s.deployRequest(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		dr.advance()
+		reply(w, http.StatusOK, dr.DeployRequest, nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{number}/operations", func(w http.ResponseWriter, r *http.Request) {
+		db, dr, err := s.deployRequest(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		reply(w, http.StatusOK, map[string]interface{}{"data": dr.operations(db.Name)}, nil)
+	})
+	mux.HandleFunc("PATCH "+prefix+"/{number}", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.updateDeployRequest(r, func(_ *database, dr *deployRequest) *apiError {
+			var req planetscale.CloseRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if req.State != string(planetscale.DeployRequestClosed) {
+				return unprocessable("unsupported state " + req.State)
+			}
+			switch dr.DeploymentState {
+			case planetscale.DeploymentQueued, planetscale.DeploymentInProgress, planetscale.DeploymentPendingCutover, planetscale.DeploymentInProgressCutover:
+				return unprocessable("deploy request is being deployed")
+			}
+			dr.close()
+			return nil
+		})
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/deploy", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.updateDeployRequest(r, func(db *database, dr *deployRequest) *apiError {
+			var req planetscale.PerformDeployRequest
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if dr.DeploymentState != planetscale.DeploymentPending && dr.DeploymentState != planetscale.DeploymentReady {
+				return unprocessable("deploy request is not deployable")
+			}
+			if db.RequireApprovalForDeploy && !dr.Approved {
+				return unprocessable("deploy request must be approved before it can be deployed")
+			}
+			t := now()
+			dr.Deployment.Deployable = true
+			dr.Deployment.InstantDDL = req.InstantDDL
+			dr.Deployment.QueuedAt = &t
+			dr.setDeploymentState(planetscale.DeploymentQueued)
+			return nil
+		})
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/cancel", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.transitionDeployRequest(r, planetscale.DeploymentInProgressCancel, planetscale.DeploymentQueued, planetscale.DeploymentInProgress, planetscale.DeploymentPendingCutover)
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/apply-deploy", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.transitionDeployRequest(r, planetscale.DeploymentInProgressCutover, planetscale.DeploymentPendingCutover)
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/force-cutover", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.transitionDeployRequest(r, planetscale.DeploymentInProgressCutover, planetscale.DeploymentPendingCutover)
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/skip-revert", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.transitionDeployRequest(r, planetscale.DeploymentComplete, planetscale.DeploymentCompletePendingRevert)
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/revert", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.transitionDeployRequest(r, planetscale.DeploymentInProgressRevert, planetscale.DeploymentCompletePendingRevert)
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("PUT "+prefix+"/{number}/auto-apply", func(w http.ResponseWriter, r *http.Request) {
+		dr, err := s.updateDeployRequest(r, func(_ *database, dr *deployRequest) *apiError {
+			var req struct {
+				Enable bool `json:"enable"`
+			}
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			dr.autoCutover = req.Enable
+			return nil
+		})
+		reply(w, http.StatusOK, dr, err)
+	})
+	mux.HandleFunc("POST "+prefix+"/{number}/reviews", func(w http.ResponseWriter, r *http.Request) {
+		var review *planetscale.DeployRequestReview
+		_, err := s.updateDeployRequest(r, func(_ *database, dr *deployRequest) *apiError {
+			var req struct {
+				State string `json:"state"`
+				Body  string `json:"body"`
+			}
+			if err := decode(r, &req); err != nil {
+				return err
+			}
+			if req.State == planetscale.ReviewApprove.String() {
+				dr.Approved = true
+			}
+			t := now()
+			review = &planetscale.DeployRequestReview{
+				ID:        newID(),
+				Body:      req.Body,
+				State:     req.State,
+				Actor:     fakeActor,
+				CreatedAt: t,
+				UpdatedAt: t,
+			}
+			return nil
+		})
+		reply(w, http.StatusCreated, review, err)
+	})
+}
+
+// deployRequest returns the deploy request identified by the org, db and
+// number path values.
+func (s *Server) deployRequest(r *http.Request) (*database, *deployRequest, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	number, parseErr := strconv.ParseUint(r.PathValue("number"), 10, 64)
+	if parseErr != nil {
+		return nil, nil, notFound("deploy request not found")
+	}
+	dr, ok := find(db.deployRequests, func(dr *deployRequest) bool { return dr.Number == number })
+	if !ok {
+		return nil, nil, notFound("deploy request not found")
+	}
+	return db, dr, nil
+}
+
+func (s *Server) updateDeployRequest(r *http.Request, update func(*database, *deployRequest) *apiError) (*planetscale.DeployRequest, *apiError) {
+	db, dr, err := s.deployRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(db, dr); err != nil {
+		return nil, err
+	}
+	return dr.DeployRequest, nil
+}
+
+// transitionDeployRequest moves the deployment to state if it is currently in
+// one of from.
+func (s *Server) transitionDeployRequest(r *http.Request, state planetscale.DeploymentState, from ...planetscale.DeploymentState) (*planetscale.DeployRequest, *apiError) {
+	return s.updateDeployRequest(r, func(_ *database, dr *deployRequest) *apiError {
+		for _, f := range from {
+			if dr.DeploymentState == f {
+				dr.setDeploymentState(state)
+				return nil
+			}
+		}
+		return unprocessable("deploy request is in state " + string(dr.DeploymentState))
+	})
+}
+
+func (s *Server) createDeployRequest(r *http.Request) (*planetscale.DeployRequest, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.CreateDeployRequestRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	b, ok := db.branch(req.Branch)
+	if !ok {
+		return nil, unprocessable("branch " + req.Branch + " does not exist")
+	}
+	if b.Production {
+		return nil, unprocessable("deploy requests cannot be opened from a production branch")
+	}
+	into := req.IntoBranch
+	if into == "" {
+		into = db.DefaultBranch
+	}
+	if _, ok := db.branch(into); !ok {
+		return nil, unprocessable("branch " + into + " does not exist")
+	}
+
+	db.lastDeployRequest++
+	created := now()
+	dr := &deployRequest{
+		DeployRequest: &planetscale.DeployRequest{
+			ID:              newID(),
+			Branch:          req.Branch,
+			IntoBranch:      into,
+			Actor:           fakeActor,
+			Number:          db.lastDeployRequest,
+			State:           planetscale.DeployRequestOpen,
+			DeploymentState: planetscale.DeploymentPending,
+			Notes:           req.Notes,
+			Deployment: &planetscale.Deployment{
+				ID:                  newID(),
+				State:               planetscale.DeploymentPending,
+				DeployRequestNumber: db.lastDeployRequest,
+				IntoBranch:          into,
+				Actor:               &fakeActor,
+				CreatedAt:           created,
+				UpdatedAt:           created,
+			},
+			HtmlURL:   db.HtmlURL + "/deploy-requests/" + strconv.FormatUint(db.lastDeployRequest, 10),
+			CreatedAt: created,
+			UpdatedAt: created,
+		},
+		autoCutover: req.AutoCutover,
+	}
+	db.deployRequests = append(db.deployRequests, dr)
+	return dr.DeployRequest, nil
+}
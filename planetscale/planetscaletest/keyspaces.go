@@ -0,0 +1,147 @@
+package planetscaletest
+
+import (
+	"net/http"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+const defaultReplicas = 2
+
+type keyspace struct {
+	*planetscale.Keyspace
+
+	vschema string
+}
+
+func newKeyspace(name, clusterSize string, shards, extraReplicas int) *keyspace {
+	created := now()
+	return &keyspace{
+		Keyspace: &planetscale.Keyspace{
+			ID:            newID(),
+			Name:          name,
+			Shards:        shards,
+			Sharded:       shards > 1,
+			Replicas:      uint64(defaultReplicas + extraReplicas),
+			ExtraReplicas: uint64(extraReplicas),
+			ClusterSize:   clusterSize,
+			CreatedAt:     created,
+			UpdatedAt:     created,
+		},
+		vschema: "{}",
+	}
+}
+
+// advance moves a newly created keyspace to the ready state.
+func (k *keyspace) advance() {
+	if !k.Ready {
+		k.Ready = true
+		k.UpdatedAt = now()
+	}
+}
+
+func (s *Server) registerKeyspaces(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases/{db}/branches/{branch}/keyspaces"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		ks, err := s.createKeyspace(r)
+		reply(w, http.StatusCreated, ks, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		_, b, err := s.branch(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		keyspaces := make([]*planetscale.Keyspace, 0, len(b.keyspaces))
+		for _, ks := range b.keyspaces {
+			ks.advance()
+			keyspaces = append(keyspaces, ks.Keyspace)
+		}
+		reply(w, http.StatusOK, paginate(r, keyspaces), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{keyspace}", func(w http.ResponseWriter, r *http.Request) {
+		_, ks, err := s.keyspace(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		ks.advance()
+		reply(w, http.StatusOK, ks.Keyspace, nil)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{keyspace}", func(w http.ResponseWriter, r *http.Request) {
+		b, ks, err := s.keyspace(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		b.keyspaces, _ = remove(b.keyspaces, func(other *keyspace) bool { return other == ks })
+		reply(w, http.StatusNoContent, nil, nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{keyspace}/vschema", func(w http.ResponseWriter, r *http.Request) {
+		_, ks, err := s.keyspace(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		reply(w, http.StatusOK, &planetscale.VSchema{Raw: ks.vschema}, nil)
+	})
+	mux.HandleFunc("PATCH "+prefix+"/{keyspace}/vschema", func(w http.ResponseWriter, r *http.Request) {
+		_, ks, err := s.keyspace(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		var req planetscale.UpdateKeyspaceVSchemaRequest
+		if err := decode(r, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+		ks.vschema = req.VSchema
+		ks.UpdatedAt = now()
+		reply(w, http.StatusOK, &planetscale.VSchema{Raw: ks.vschema}, nil)
+	})
+}
+
+// keyspace returns the keyspace named by the org, db, branch and keyspace
+// path values, along with its branch.
+func (s *Server) keyspace(r *http.Request) (*branch, *keyspace, *apiError) {
+	_, b, err := s.branch(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	ks, ok := find(b.keyspaces, func(ks *keyspace) bool { return ks.Name == r.PathValue("keyspace") })
+	if !ok {
+		return nil, nil, notFound("keyspace not found")
+	}
+	return b, ks, nil
+}
+
+func (s *Server) createKeyspace(r *http.Request) (*planetscale.Keyspace, *apiError) {
+	_, b, err := s.branch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.CreateKeyspaceRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, unprocessable("name is required")
+	}
+	if _, ok := find(b.keyspaces, func(ks *keyspace) bool { return ks.Name == req.Name }); ok {
+		return nil, conflict("a keyspace named " + req.Name + " already exists")
+	}
+	if req.ClusterSize == "" {
+		return nil, unprocessable("cluster_size is required")
+	}
+	shards := req.Shards
+	if shards == 0 {
+		shards = 1
+	}
+
+	ks := newKeyspace(req.Name, req.ClusterSize, shards, req.ExtraReplicas)
+	b.keyspaces = append(b.keyspaces, ks)
+	return ks.Keyspace, nil
+}
@@ -0,0 +1,127 @@
+package planetscaletest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+func (s *Server) registerPasswords(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases/{db}/branches/{branch}/passwords"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		pw, err := s.createPassword(r)
+		reply(w, http.StatusCreated, pw, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		_, b, err := s.branch(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		reply(w, http.StatusOK, paginate(r, b.passwords), nil)
+	})
+	mux.HandleFunc("GET /v1/organizations/{org}/databases/{db}/passwords", func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.database(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		var passwords []*planetscale.DatabaseBranchPassword
+		for _, b := range db.branches {
+			passwords = append(passwords, b.passwords...)
+		}
+		reply(w, http.StatusOK, paginate(r, passwords), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, pw, err := s.password(r)
+		reply(w, http.StatusOK, pw, err)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		b, pw, err := s.password(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		b.passwords, _ = remove(b.passwords, func(other *planetscale.DatabaseBranchPassword) bool { return other == pw })
+		reply(w, http.StatusNoContent, nil, nil)
+	})
+	mux.HandleFunc("POST "+prefix+"/{id}/renew", func(w http.ResponseWriter, r *http.Request) {
+		_, pw, err := s.password(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if !pw.Renewable {
+			writeError(w, unprocessable("password is not renewable"))
+			return
+		}
+		pw.ExpiresAt = now().Add(time.Duration(pw.TTL) * time.Second)
+		reply(w, http.StatusOK, pw, nil)
+	})
+}
+
+// password returns the password identified by the org, db, branch and id
+// path values, along with its branch.
+func (s *Server) password(r *http.Request) (*branch, *planetscale.DatabaseBranchPassword, *apiError) {
+	_, b, err := s.branch(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	pw, ok := find(b.passwords, func(pw *planetscale.DatabaseBranchPassword) bool {
+		return pw.PublicID == r.PathValue("id")
+	})
+	if !ok {
+		return nil, nil, notFound("password not found")
+	}
+	return b, pw, nil
+}
+
+// createPassword stores a new password. Like the real API, only the
+// response to this call includes the plain text password.
+func (s *Server) createPassword(r *http.Request) (*planetscale.DatabaseBranchPassword, *apiError) {
+	_, b, err := s.branch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.DatabaseBranchPasswordRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	if req.TTL < 0 {
+		return nil, unprocessable("ttl must not be negative")
+	}
+	role := req.Role
+	if role == "" {
+		role = "admin"
+	}
+	name := req.Name
+	if name == "" {
+		name = "pscale_pw_" + randomString(8)
+	}
+
+	pw := &planetscale.DatabaseBranchPassword{
+		PublicID:  newID(),
+		Name:      name,
+		Hostname:  b.Region.Slug + ".connect.psdb.cloud",
+		Username:  randomString(20),
+		Role:      role,
+		Actor:     &fakeActor,
+		Branch:    *b.DatabaseBranch,
+		Region:    b.Region,
+		CreatedAt: now(),
+		TTL:       req.TTL,
+		Renewable: req.TTL > 0,
+		Replica:   req.Replica,
+	}
+	if req.TTL > 0 {
+		pw.ExpiresAt = pw.CreatedAt.Add(time.Duration(req.TTL) * time.Second)
+	}
+	b.passwords = append(b.passwords, pw)
+
+	created := *pw
+	created.PlainText = "pscale_pw_" + randomString(43)
+	return &created, nil
+}
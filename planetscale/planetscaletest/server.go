@@ -0,0 +1,248 @@
+// Package planetscaletest provides an in-memory fake of the PlanetScale API
+// for use in tests.
+//
+// The fake keeps state across calls, so resources created through a client
+// can be read, updated and deleted again:
+//
+//	fake := planetscaletest.NewServer()
+//	defer fake.Close()
+//
+//	client, err := planetscale.NewClient(planetscale.WithBaseURL(fake.URL))
+//
+// Resources that are provisioned asynchronously by the real API start in a
+// pending state and advance one step every time they are read, so code that
+// polls for readiness can be exercised without sleeping. Any organization
+// name is accepted and requests are not authenticated.
+package planetscaletest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// Server is a stateful, in-memory PlanetScale API server. It covers
+// databases, branches, deploy requests, passwords, backups, keyspaces and
+// webhooks.
+type Server struct {
+	*httptest.Server
+
+	mu   sync.Mutex
+	orgs map[string]*organization
+}
+
+type organization struct {
+	databases []*database
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		orgs: make(map[string]*organization),
+	}
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// Client returns a client that talks to the server. The given options are
+// applied after the base URL is set.
+func (s *Server) Client(opts ...planetscale.ClientOption) (*planetscale.Client, error) {
+	return planetscale.NewClient(append([]planetscale.ClientOption{planetscale.WithBaseURL(s.URL)}, opts...)...)
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	s.registerDatabases(mux)
+	s.registerBranches(mux)
+	s.registerDeployRequests(mux)
+	s.registerPasswords(mux)
+	s.registerBackups(mux)
+	s.registerKeyspaces(mux)
+	s.registerWebhooks(mux)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", newID())
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) organization(name string) *organization {
+	org, ok := s.orgs[name]
+	if !ok {
+		org = &organization{}
+		s.orgs[name] = org
+	}
+	return org
+}
+
+// apiError is returned by handlers to reply with an error response in the
+// format of the real API.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func notFound(message string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: "not_found", message: message}
+}
+
+func conflict(message string) *apiError {
+	return &apiError{status: http.StatusConflict, code: "conflict", message: message}
+}
+
+func unprocessable(message string) *apiError {
+	return &apiError{status: http.StatusUnprocessableEntity, code: "unprocessable", message: message}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, map[string]string{
+		"code":    err.code,
+		"message": err.message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// reply writes v as JSON with the given status, or err if it is not nil. A
+// nil v results in an empty response body.
+func reply(w http.ResponseWriter, status int, v interface{}, err *apiError) {
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case v == nil:
+		w.WriteHeader(status)
+	default:
+		writeJSON(w, status, v)
+	}
+}
+
+// decode reads the JSON request body into v. An empty body leaves v
+// untouched.
+func decode(r *http.Request, v interface{}) *apiError {
+	if r.ContentLength == 0 {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return &apiError{status: http.StatusBadRequest, code: "bad_request", message: "invalid JSON body: " + err.Error()}
+	}
+	return nil
+}
+
+// listResponse is the paginated envelope used by list endpoints.
+type listResponse[T any] struct {
+	Data        []T  `json:"data"`
+	CurrentPage int  `json:"current_page"`
+	NextPage    *int `json:"next_page"`
+	PrevPage    *int `json:"prev_page"`
+}
+
+const defaultPerPage = 25
+
+// paginate returns the page of items requested by the page and per_page
+// query parameters.
+func paginate[T any](r *http.Request, items []T) *listResponse[T] {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	resp := &listResponse[T]{Data: []T{}, CurrentPage: page}
+	start := (page - 1) * perPage
+	if start < len(items) {
+		end := min(start+perPage, len(items))
+		resp.Data = items[start:end]
+		if end < len(items) {
+			next := page + 1
+			resp.NextPage = &next
+		}
+	}
+	if page > 1 {
+		prev := page - 1
+		resp.PrevPage = &prev
+	}
+	return resp
+}
+
+// remove deletes the first element of items matching match.
+func remove[T any](items []T, match func(T) bool) ([]T, bool) {
+	for i, item := range items {
+		if match(item) {
+			return append(items[:i], items[i+1:]...), true
+		}
+	}
+	return items, false
+}
+
+// find returns the first element of items matching match.
+func find[T any](items []T, match func(T) bool) (T, bool) {
+	for _, item := range items {
+		if match(item) {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+const idAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// newID returns a random public ID in the format used by the API.
+func newID() string {
+	return randomString(12)
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	for i := range b {
+		b[i] = idAlphabet[int(b[i])%len(idAlphabet)]
+	}
+	return string(b)
+}
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+var (
+	defaultRegion = planetscale.Region{
+		Slug:      "us-east",
+		Provider:  "AWS",
+		Name:      "AWS us-east-1",
+		Location:  "Ashburn, Virginia",
+		Enabled:   true,
+		IsDefault: true,
+	}
+
+	fakeActor = planetscale.Actor{
+		Type: "User",
+		ID:   "planetscaletest",
+		Name: "planetscaletest",
+	}
+)
+
+func regionOrDefault(slug string) planetscale.Region {
+	if slug == "" {
+		return defaultRegion
+	}
+	return planetscale.Region{Slug: slug, Provider: "AWS", Name: slug, Enabled: true}
+}
@@ -0,0 +1,317 @@
+package planetscaletest
+
+import (
+	"context"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+const testOrg = "my-org"
+
+func newTestClient(t *testing.T) *planetscale.Client {
+	t.Helper()
+
+	fake := NewServer()
+	t.Cleanup(fake.Close)
+
+	client, err := fake.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func createDatabase(t *testing.T, client *planetscale.Client, name string) *planetscale.Database {
+	t.Helper()
+
+	db, err := client.Databases.Create(context.Background(), &planetscale.CreateDatabaseRequest{
+		Organization: testOrg,
+		Name:         name,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestDatabases(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	db := createDatabase(t, client, "my-db")
+	c.Assert(db.State, qt.Equals, planetscale.DatabasePending)
+	c.Assert(db.DefaultBranch, qt.Equals, "main")
+	c.Assert(db.CreatedAt.IsZero(), qt.IsFalse)
+
+	_, err := client.Databases.Create(ctx, &planetscale.CreateDatabaseRequest{Organization: testOrg, Name: "my-db"})
+	c.Assert(err, qt.ErrorIs, &planetscale.Error{Code: planetscale.ErrConflict})
+
+	db, err = client.Databases.Get(ctx, &planetscale.GetDatabaseRequest{Organization: testOrg, Database: "my-db"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(db.State, qt.Equals, planetscale.DatabaseReady)
+
+	createDatabase(t, client, "other-db")
+	var names []string
+	for db, err := range client.Databases.All(ctx, &planetscale.ListDatabasesRequest{Organization: testOrg}, planetscale.WithPerPage(1)) {
+		c.Assert(err, qt.IsNil)
+		names = append(names, db.Name)
+	}
+	c.Assert(names, qt.DeepEquals, []string{"my-db", "other-db"})
+
+	requireApproval := true
+	db, err = client.Databases.UpdateSettings(ctx, &planetscale.UpdateDatabaseSettingsRequest{
+		Organization:             testOrg,
+		Database:                 "my-db",
+		RequireApprovalForDeploy: &requireApproval,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(db.RequireApprovalForDeploy, qt.IsTrue)
+
+	deletion, err := client.Databases.Delete(ctx, &planetscale.DeleteDatabaseRequest{Organization: testOrg, Database: "my-db"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(deletion.ID, qt.Not(qt.Equals), "")
+
+	_, err = client.Databases.Get(ctx, &planetscale.GetDatabaseRequest{Organization: testOrg, Database: "my-db"})
+	c.Assert(err, qt.ErrorIs, &planetscale.Error{Code: planetscale.ErrNotFound})
+}
+
+func TestBranches(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+	createDatabase(t, client, "my-db")
+
+	branch, err := client.DatabaseBranches.Create(ctx, &planetscale.CreateDatabaseBranchRequest{
+		Organization: testOrg,
+		Database:     "my-db",
+		Name:         "feature",
+		ParentBranch: "main",
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Ready, qt.IsFalse)
+	c.Assert(branch.ParentBranch, qt.Equals, "main")
+	c.Assert(branch.ID, qt.HasLen, 12)
+
+	branch, err = client.DatabaseBranches.Get(ctx, &planetscale.GetDatabaseBranchRequest{Organization: testOrg, Database: "my-db", Branch: "feature"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Ready, qt.IsTrue)
+
+	branch, err = client.DatabaseBranches.Promote(ctx, &planetscale.PromoteRequest{Organization: testOrg, Database: "my-db", Branch: "feature"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Production, qt.IsTrue)
+
+	err = client.DatabaseBranches.Delete(ctx, &planetscale.DeleteDatabaseBranchRequest{Organization: testOrg, Database: "my-db", Branch: "main"})
+	c.Assert(err, qt.ErrorMatches, "the default branch cannot be deleted")
+
+	err = client.DatabaseBranches.Delete(ctx, &planetscale.DeleteDatabaseBranchRequest{Organization: testOrg, Database: "my-db", Branch: "feature"})
+	c.Assert(err, qt.IsNil)
+
+	branches, err := client.DatabaseBranches.List(ctx, &planetscale.ListDatabaseBranchesRequest{Organization: testOrg, Database: "my-db"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(branches, qt.HasLen, 1)
+	c.Assert(branches[0].Name, qt.Equals, "main")
+}
+
+func TestDeployRequests(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+	createDatabase(t, client, "my-db")
+
+	_, err := client.DatabaseBranches.Create(ctx, &planetscale.CreateDatabaseBranchRequest{Organization: testOrg, Database: "my-db", Name: "feature"})
+	c.Assert(err, qt.IsNil)
+
+	dr, err := client.DeployRequests.Create(ctx, &planetscale.CreateDeployRequestRequest{Organization: testOrg, Database: "my-db", Branch: "feature"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(dr.Number, qt.Equals, uint64(1))
+	c.Assert(dr.IntoBranch, qt.Equals, "main")
+	c.Assert(dr.DeploymentState, qt.Equals, planetscale.DeploymentPending)
+
+	dr, err = client.DeployRequests.Deploy(ctx, &planetscale.PerformDeployRequest{Organization: testOrg, Database: "my-db", Number: 1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(dr.DeploymentState, qt.Equals, planetscale.DeploymentQueued)
+
+	get := func() *planetscale.DeployRequest {
+		dr, err := client.DeployRequests.Get(ctx, &planetscale.GetDeployRequestRequest{Organization: testOrg, Database: "my-db", Number: 1})
+		c.Assert(err, qt.IsNil)
+		return dr
+	}
+	c.Assert(get().DeploymentState, qt.Equals, planetscale.DeploymentInProgress)
+
+	ops, err := client.DeployRequests.GetDeployOperations(ctx, &planetscale.GetDeployOperationsRequest{Organization: testOrg, Database: "my-db", Number: 1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(ops, qt.HasLen, 1)
+	c.Assert(ops[0].State, qt.Equals, "in_progress")
+
+	c.Assert(get().DeploymentState, qt.Equals, planetscale.DeploymentPendingCutover)
+	c.Assert(get().DeploymentState, qt.Equals, planetscale.DeploymentPendingCutover)
+
+	dr, err = client.DeployRequests.ApplyDeploy(ctx, &planetscale.ApplyDeployRequestRequest{Organization: testOrg, Database: "my-db", Number: 1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(dr.DeploymentState, qt.Equals, planetscale.DeploymentInProgressCutover)
+
+	dr = get()
+	c.Assert(dr.DeploymentState, qt.Equals, planetscale.DeploymentCompletePendingRevert)
+	c.Assert(dr.State, qt.Equals, planetscale.DeployRequestClosed)
+	c.Assert(dr.DeployedAt, qt.Not(qt.IsNil))
+
+	dr, err = client.DeployRequests.SkipRevertDeploy(ctx, &planetscale.SkipRevertDeployRequestRequest{Organization: testOrg, Database: "my-db", Number: 1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(dr.DeploymentState, qt.Equals, planetscale.DeploymentComplete)
+
+	_, err = client.DeployRequests.Deploy(ctx, &planetscale.PerformDeployRequest{Organization: testOrg, Database: "my-db", Number: 1})
+	c.Assert(err, qt.ErrorMatches, "deploy request is not deployable")
+
+	drs, err := client.DeployRequests.List(ctx, &planetscale.ListDeployRequestsRequest{Organization: testOrg, Database: "my-db", State: "open"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(drs, qt.HasLen, 0)
+}
+
+func TestPasswords(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+	createDatabase(t, client, "my-db")
+
+	pw, err := client.Passwords.Create(ctx, &planetscale.DatabaseBranchPasswordRequest{
+		Organization: testOrg,
+		Database:     "my-db",
+		Branch:       "main",
+		Name:         "app",
+		TTL:          3600,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(pw.PlainText, qt.Matches, "pscale_pw_.+")
+	c.Assert(pw.Role, qt.Equals, "admin")
+	c.Assert(pw.ExpiresAt.Sub(pw.CreatedAt).Hours(), qt.Equals, 1.0)
+
+	got, err := client.Passwords.Get(ctx, &planetscale.GetDatabaseBranchPasswordRequest{Organization: testOrg, Database: "my-db", Branch: "main", PasswordId: pw.PublicID})
+	c.Assert(err, qt.IsNil)
+	c.Assert(got.Name, qt.Equals, "app")
+	c.Assert(got.PlainText, qt.Equals, "")
+
+	passwords, err := client.Passwords.List(ctx, &planetscale.ListDatabaseBranchPasswordRequest{Organization: testOrg, Database: "my-db"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(passwords, qt.HasLen, 1)
+
+	err = client.Passwords.Delete(ctx, &planetscale.DeleteDatabaseBranchPasswordRequest{Organization: testOrg, Database: "my-db", Branch: "main", PasswordId: pw.PublicID})
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Passwords.Get(ctx, &planetscale.GetDatabaseBranchPasswordRequest{Organization: testOrg, Database: "my-db", Branch: "main", PasswordId: pw.PublicID})
+	c.Assert(err, qt.ErrorIs, &planetscale.Error{Code: planetscale.ErrNotFound})
+}
+
+func TestBackups(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+	createDatabase(t, client, "my-db")
+
+	backup, err := client.Backups.Create(ctx, &planetscale.CreateBackupRequest{
+		Organization:   testOrg,
+		Database:       "my-db",
+		Branch:         "main",
+		RetentionUnit:  "day",
+		RetentionValue: 3,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(backup.State, qt.Equals, planetscale.BackupPending)
+	c.Assert(backup.ExpiresAt, qt.Equals, backup.CreatedAt.AddDate(0, 0, 3))
+
+	getReq := &planetscale.GetBackupRequest{Organization: testOrg, Database: "my-db", Branch: "main", Backup: backup.PublicID}
+	backup, err = client.Backups.Get(ctx, getReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(backup.State, qt.Equals, planetscale.BackupRunning)
+
+	backup, err = client.Backups.Get(ctx, getReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(backup.State, qt.Equals, planetscale.BackupSuccess)
+	c.Assert(backup.Size, qt.Not(qt.Equals), int64(0))
+
+	err = client.Backups.Delete(ctx, &planetscale.DeleteBackupRequest{Organization: testOrg, Database: "my-db", Branch: "main", Backup: backup.PublicID})
+	c.Assert(err, qt.IsNil)
+
+	backups, err := client.Backups.List(ctx, &planetscale.ListBackupsRequest{Organization: testOrg, Database: "my-db", Branch: "main"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(backups, qt.HasLen, 0)
+}
+
+func TestKeyspaces(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+	createDatabase(t, client, "my-db")
+
+	ks, err := client.Keyspaces.Create(ctx, &planetscale.CreateKeyspaceRequest{
+		Organization: testOrg,
+		Database:     "my-db",
+		Branch:       "main",
+		Name:         "sharded",
+		ClusterSize:  "PS_10",
+		Shards:       2,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(ks.Sharded, qt.IsTrue)
+	c.Assert(ks.Ready, qt.IsFalse)
+
+	keyspaces, err := client.Keyspaces.List(ctx, &planetscale.ListKeyspacesRequest{Organization: testOrg, Database: "my-db", Branch: "main"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(keyspaces, qt.HasLen, 2)
+	c.Assert(keyspaces[0].Name, qt.Equals, "my-db")
+	c.Assert(keyspaces[1].Ready, qt.IsTrue)
+
+	vschema, err := client.Keyspaces.UpdateVSchema(ctx, &planetscale.UpdateKeyspaceVSchemaRequest{
+		Organization: testOrg,
+		Database:     "my-db",
+		Branch:       "main",
+		Keyspace:     "sharded",
+		VSchema:      `{"sharded": true}`,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(vschema.Raw, qt.Equals, `{"sharded": true}`)
+
+	err = client.Keyspaces.Delete(ctx, &planetscale.DeleteKeyspaceRequest{Organization: testOrg, Database: "my-db", Branch: "main", Keyspace: "sharded"})
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Keyspaces.Get(ctx, &planetscale.GetKeyspaceRequest{Organization: testOrg, Database: "my-db", Branch: "main", Keyspace: "sharded"})
+	c.Assert(err, qt.ErrorIs, &planetscale.Error{Code: planetscale.ErrNotFound})
+}
+
+func TestWebhooks(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+	client := newTestClient(t)
+	createDatabase(t, client, "my-db")
+
+	webhook, err := client.Webhooks.Create(ctx, &planetscale.CreateWebhookRequest{
+		Organization: testOrg,
+		Database:     "my-db",
+		URL:          "https://example.com/hook",
+		Events:       []string{"branch.ready"},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(webhook.Enabled, qt.IsTrue)
+	c.Assert(webhook.Secret, qt.Not(qt.Equals), "")
+
+	enabled := false
+	webhook, err = client.Webhooks.Update(ctx, &planetscale.UpdateWebhookRequest{Organization: testOrg, Database: "my-db", ID: webhook.ID, Enabled: &enabled})
+	c.Assert(err, qt.IsNil)
+	c.Assert(webhook.Enabled, qt.IsFalse)
+
+	err = client.Webhooks.Test(ctx, &planetscale.TestWebhookRequest{Organization: testOrg, Database: "my-db", ID: webhook.ID})
+	c.Assert(err, qt.IsNil)
+
+	webhook, err = client.Webhooks.Get(ctx, &planetscale.GetWebhookRequest{Organization: testOrg, Database: "my-db", ID: webhook.ID})
+	c.Assert(err, qt.IsNil)
+	c.Assert(webhook.LastSentSuccess, qt.IsTrue)
+
+	err = client.Webhooks.Delete(ctx, &planetscale.DeleteWebhookRequest{Organization: testOrg, Database: "my-db", ID: webhook.ID})
+	c.Assert(err, qt.IsNil)
+
+	webhooks, err := client.Webhooks.List(ctx, &planetscale.ListWebhooksRequest{Organization: testOrg, Database: "my-db"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(webhooks, qt.HasLen, 0)
+}
@@ -0,0 +1,112 @@
+package planetscaletest
+
+import (
+	"net/http"
+
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+func (s *Server) registerWebhooks(mux *http.ServeMux) {
+	const prefix = "/v1/organizations/{org}/databases/{db}/webhooks"
+
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		webhook, err := s.createWebhook(r)
+		reply(w, http.StatusCreated, webhook, err)
+	})
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		db, err := s.database(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		reply(w, http.StatusOK, paginate(r, db.webhooks), nil)
+	})
+	mux.HandleFunc("GET "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, webhook, err := s.webhook(r)
+		reply(w, http.StatusOK, webhook, err)
+	})
+	mux.HandleFunc("PATCH "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, webhook, err := s.webhook(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		var req planetscale.UpdateWebhookRequest
+		if err := decode(r, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+		if req.URL != nil {
+			webhook.URL = *req.URL
+		}
+		setBool(&webhook.Enabled, req.Enabled)
+		if req.Events != nil {
+			webhook.Events = req.Events
+		}
+		webhook.UpdatedAt = now()
+		reply(w, http.StatusOK, webhook, nil)
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		db, webhook, err := s.webhook(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		db.webhooks, _ = remove(db.webhooks, func(other *planetscale.Webhook) bool { return other == webhook })
+		reply(w, http.StatusNoContent, nil, nil)
+	})
+	mux.HandleFunc("POST "+prefix+"/{id}/test", func(w http.ResponseWriter, r *http.Request) {
+		_, webhook, err := s.webhook(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		webhook.LastSentAt = now()
+		webhook.LastSentSuccess = true
+		webhook.LastSentResult = "200 OK"
+		reply(w, http.StatusOK, nil, nil)
+	})
+}
+
+// webhook returns the webhook identified by the org, db and id path values,
+// along with its database.
+func (s *Server) webhook(r *http.Request) (*database, *planetscale.Webhook, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	webhook, ok := find(db.webhooks, func(w *planetscale.Webhook) bool { return w.ID == r.PathValue("id") })
+	if !ok {
+		return nil, nil, notFound("webhook not found")
+	}
+	return db, webhook, nil
+}
+
+func (s *Server) createWebhook(r *http.Request) (*planetscale.Webhook, *apiError) {
+	db, err := s.database(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req planetscale.CreateWebhookRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	if req.URL == "" {
+		return nil, unprocessable("url is required")
+	}
+
+	created := now()
+	webhook := &planetscale.Webhook{
+		ID:        newID(),
+		URL:       req.URL,
+		Secret:    randomString(32),
+		Enabled:   true,
+		Events:    req.Events,
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+	setBool(&webhook.Enabled, req.Enabled)
+	db.webhooks = append(db.webhooks, webhook)
+	return webhook, nil
+}
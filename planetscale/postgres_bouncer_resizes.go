@@ -8,6 +8,10 @@ import (
 	"time"
 )
 
+// States of a PostgresBouncerResizeRequest. They predate ResizeState and
+// stay untyped, so they compare with both ResizeState values and strings.
+// The State field itself is a ResizeState; convert it with string(r.State)
+// where a string is needed.
 const (
 	PostgresBouncerResizeStatePending   = "pending"
 	PostgresBouncerResizeStateResizing  = "resizing"
@@ -18,7 +22,7 @@ const (
 // PostgresBouncerResizeRequest is an asynchronous dedicated-PgBouncer change.
 type PostgresBouncerResizeRequest struct {
 	ID                      string                `json:"id"`
-	State                   string                `json:"state"`
+	State                   ResizeState           `json:"state"`
 	ReplicasPerCell         int                   `json:"replicas_per_cell"`
 	Target                  string                `json:"target"`
 	Parameters              map[string]any        `json:"parameters"`
@@ -37,7 +41,7 @@ type PostgresBouncerResizeRequest struct {
 
 // Finished reports whether the resize request is in a terminal state.
 func (r *PostgresBouncerResizeRequest) Finished() bool {
-	return r.State == PostgresBouncerResizeStateCompleted || r.State == PostgresBouncerResizeStateCanceled
+	return r.State.IsTerminal()
 }
 
 type postgresBouncerResizesResponse struct {
@@ -81,7 +85,7 @@ func (s *postgresBouncersService) ListResizes(ctx context.Context, listReq *List
 		}
 	}
 
-	req, err := s.client.newRequest(http.MethodGet, postgresBouncerResizesAPIPath(listReq.Organization, listReq.Database, listReq.Branch, listReq.Bouncer), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, postgresBouncerResizesAPIPath(listReq.Organization, listReq.Database, listReq.Branch, listReq.Bouncer), nil, WithQueryParams(*listOpts.URLValues), withOperation("PostgresBouncers", "ListResizes"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list postgres bouncer resizes: %w", err)
 	}
@@ -96,7 +100,7 @@ func (s *postgresBouncersService) ListResizes(ctx context.Context, listReq *List
 
 // Resize upserts a resize request for a dedicated PgBouncer.
 func (s *postgresBouncersService) Resize(ctx context.Context, resizeReq *ResizePostgresBouncerRequest) (*PostgresBouncerResizeRequest, error) {
-	req, err := s.client.newRequest(http.MethodPatch, postgresBouncerResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch, resizeReq.Bouncer), resizeReq)
+	req, err := s.client.newRequest(http.MethodPatch, postgresBouncerResizesAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch, resizeReq.Bouncer), resizeReq, withOperation("PostgresBouncers", "Resize"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for resize postgres bouncer: %w", err)
 	}
@@ -118,7 +122,7 @@ func (s *postgresBouncersService) Resize(ctx context.Context, resizeReq *ResizeP
 
 // CancelResizes cancels unfinished resize requests for a dedicated PgBouncer.
 func (s *postgresBouncersService) CancelResizes(ctx context.Context, cancelReq *CancelPostgresBouncerResizesRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, postgresBouncerResizesAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch, cancelReq.Bouncer), nil)
+	req, err := s.client.newRequest(http.MethodDelete, postgresBouncerResizesAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch, cancelReq.Bouncer), nil, withOperation("PostgresBouncers", "CancelResizes"))
 	if err != nil {
 		return fmt.Errorf("error creating request for cancel postgres bouncer resizes: %w", err)
 	}
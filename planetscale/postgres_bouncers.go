@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -62,6 +63,7 @@ type PostgresBouncer struct {
 }
 
 type postgresBouncersResponse struct {
+	Pagination
 	Bouncers []*PostgresBouncer `json:"data"`
 }
 
@@ -89,6 +91,10 @@ type CreatePostgresBouncerRequest struct {
 	Target          string `json:"target"`
 	BouncerSize     string `json:"bouncer_size,omitempty"`
 	ReplicasPerCell *int   `json:"replicas_per_cell,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // DeletePostgresBouncerRequest encapsulates deleting a PgBouncer by name.
@@ -102,12 +108,14 @@ type DeletePostgresBouncerRequest struct {
 // PostgresBouncersService is an interface for the PlanetScale PgBouncer API.
 type PostgresBouncersService interface {
 	List(context.Context, *ListPostgresBouncersRequest, ...ListOption) ([]*PostgresBouncer, error)
+	All(context.Context, *ListPostgresBouncersRequest, ...ListOption) iter.Seq2[*PostgresBouncer, error]
 	Get(context.Context, *GetPostgresBouncerRequest) (*PostgresBouncer, error)
 	Create(context.Context, *CreatePostgresBouncerRequest) (*PostgresBouncer, error)
 	Delete(context.Context, *DeletePostgresBouncerRequest) error
 	ListResizes(context.Context, *ListPostgresBouncerResizesRequest, ...ListOption) ([]*PostgresBouncerResizeRequest, error)
 	Resize(context.Context, *ResizePostgresBouncerRequest) (*PostgresBouncerResizeRequest, error)
 	CancelResizes(context.Context, *CancelPostgresBouncerResizesRequest) error
+	WaitForResize(context.Context, *WaitForPostgresBouncerResizeRequest, ...WaitOption) (*PostgresBouncerResizeRequest, error)
 }
 
 type postgresBouncersService struct {
@@ -124,7 +132,7 @@ func (s *postgresBouncersService) List(ctx context.Context, listReq *ListPostgre
 		}
 	}
 
-	req, err := s.client.newRequest(http.MethodGet, postgresBouncersAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, postgresBouncersAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("PostgresBouncers", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list postgres bouncers: %w", err)
 	}
@@ -133,12 +141,21 @@ func (s *postgresBouncersService) List(ctx context.Context, listReq *ListPostgre
 	if err := s.client.do(ctx, req, &resp); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(resp.Pagination)
 
 	return resp.Bouncers, nil
 }
 
+// All returns an iterator over all PgBouncers of a Postgres branch, fetching
+// pages as needed.
+func (s *postgresBouncersService) All(ctx context.Context, listReq *ListPostgresBouncersRequest, opts ...ListOption) iter.Seq2[*PostgresBouncer, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*PostgresBouncer, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 func (s *postgresBouncersService) Get(ctx context.Context, getReq *GetPostgresBouncerRequest) (*PostgresBouncer, error) {
-	req, err := s.client.newRequest(http.MethodGet, postgresBouncerAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Bouncer), nil)
+	req, err := s.client.newRequest(http.MethodGet, postgresBouncerAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Bouncer), nil, withOperation("PostgresBouncers", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get postgres bouncer: %w", err)
 	}
@@ -152,7 +169,7 @@ func (s *postgresBouncersService) Get(ctx context.Context, getReq *GetPostgresBo
 }
 
 func (s *postgresBouncersService) Create(ctx context.Context, createReq *CreatePostgresBouncerRequest) (*PostgresBouncer, error) {
-	req, err := s.client.newRequest(http.MethodPost, postgresBouncersAPIPath(createReq.Organization, createReq.Database, createReq.Branch), createReq)
+	req, err := s.client.newRequest(http.MethodPost, postgresBouncersAPIPath(createReq.Organization, createReq.Database, createReq.Branch), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("PostgresBouncers", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for create postgres bouncer: %w", err)
 	}
@@ -166,7 +183,7 @@ func (s *postgresBouncersService) Create(ctx context.Context, createReq *CreateP
 }
 
 func (s *postgresBouncersService) Delete(ctx context.Context, deleteReq *DeletePostgresBouncerRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, postgresBouncerAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.Bouncer), nil)
+	req, err := s.client.newRequest(http.MethodDelete, postgresBouncerAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.Bouncer), nil, withOperation("PostgresBouncers", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating request for delete postgres bouncer: %w", err)
 	}
@@ -207,7 +207,7 @@ func TestPostgresBouncers_ListResizes(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(resizes, qt.HasLen, 1)
 	c.Assert(resizes[0].ID, qt.Equals, "resize-1")
-	c.Assert(resizes[0].State, qt.Equals, PostgresBouncerResizeStatePending)
+	c.Assert(resizes[0].State, qt.Equals, ResizeState(PostgresBouncerResizeStatePending))
 	c.Assert(resizes[0].SKU.Name, qt.Equals, "PGB_10")
 	c.Assert(resizes[0].Finished(), qt.IsFalse)
 }
@@ -258,7 +258,7 @@ func TestPostgresBouncers_Resize(t *testing.T) {
 	})
 	c.Assert(err, qt.IsNil)
 	c.Assert(resize.ID, qt.Equals, "resize-1")
-	c.Assert(resize.State, qt.Equals, PostgresBouncerResizeStatePending)
+	c.Assert(resize.State, qt.Equals, ResizeState(PostgresBouncerResizeStatePending))
 }
 
 func TestPostgresBouncers_CancelResizes(t *testing.T) {
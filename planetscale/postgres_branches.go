@@ -2,7 +2,9 @@ package planetscale
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"path"
@@ -11,25 +13,84 @@ import (
 
 // PostgresBranch represents a Postgres branch in the PlanetScale API.
 type PostgresBranch struct {
-	ID                  string    `json:"id"`
-	Name                string    `json:"name"`
-	ClusterName         string    `json:"cluster_name"`
-	ClusterDisplayName  string    `json:"cluster_display_name"`
-	ClusterArchitecture string    `json:"cluster_architecture"`
-	ClusterIOPS         int       `json:"cluster_iops"`
-	State               string    `json:"state"`
-	CreatedAt           time.Time `json:"created_at"`
-	UpdatedAt           time.Time `json:"updated_at"`
-	Actor               Actor     `json:"actor"`
-	Production          bool      `json:"production"`
-	Ready               bool      `json:"ready"`
-	ParentBranch        string    `json:"parent_branch"`
-	Region              Region    `json:"region"`
-	Kind                string    `json:"kind"`
-	Replicas            int       `json:"replicas"`
+	ID                  string              `json:"id"`
+	Name                string              `json:"name"`
+	ClusterName         string              `json:"cluster_name"`
+	ClusterDisplayName  string              `json:"cluster_display_name"`
+	ClusterArchitecture string              `json:"cluster_architecture"`
+	ClusterIOPS         int                 `json:"cluster_iops"`
+	State               PostgresBranchState `json:"state"`
+	CreatedAt           time.Time           `json:"created_at"`
+	UpdatedAt           time.Time           `json:"updated_at"`
+	Actor               Actor               `json:"actor"`
+	Production          bool                `json:"production"`
+	Ready               bool                `json:"ready"`
+	ParentBranch        string              `json:"parent_branch"`
+	Region              Region              `json:"region"`
+	Kind                string              `json:"kind"`
+	Replicas            int                 `json:"replicas"`
+
+	// Extra keeps the response fields this type doesn't declare. A map
+	// field makes PostgresBranch incomparable with ==, so match branches by
+	// ID.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the branch, keeping undeclared fields in Extra.
+func (b *PostgresBranch) UnmarshalJSON(data []byte) error {
+	type postgresBranch PostgresBranch
+	extra, err := unmarshalWithExtra(data, (*postgresBranch)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+	return nil
+}
+
+// PostgresBranchState is the state of a Postgres branch.
+type PostgresBranchState string
+
+const (
+	PostgresBranchPending         PostgresBranchState = "pending"
+	PostgresBranchReady           PostgresBranchState = "ready"
+	PostgresBranchSleepInProgress PostgresBranchState = "sleep_in_progress"
+	PostgresBranchSleeping        PostgresBranchState = "sleeping"
+	PostgresBranchAwakening       PostgresBranchState = "awakening"
+)
+
+var postgresBranchStates = map[PostgresBranchState]stateClass{
+	PostgresBranchPending:         stateInProgress,
+	PostgresBranchReady:           stateFinished,
+	PostgresBranchSleepInProgress: stateInProgress,
+	PostgresBranchSleeping:        stateFinished,
+	PostgresBranchAwakening:       stateInProgress,
+}
+
+// IsKnown reports whether this package declares s.
+func (s PostgresBranchState) IsKnown() bool {
+	return postgresBranchStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the branch is ready or asleep, states it
+// doesn't leave on its own.
+func (s PostgresBranchState) IsTerminal() bool {
+	return postgresBranchStates[s].terminal()
+}
+
+// IsFailed reports whether the branch failed. No known Postgres branch
+// state is a failure.
+func (s PostgresBranchState) IsFailed() bool {
+	return postgresBranchStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the branch is being created, put to
+// sleep or woken up.
+func (s PostgresBranchState) IsInProgress() bool {
+	return postgresBranchStates[s] == stateInProgress
 }
 
 type postgresBranchesResponse struct {
+	Pagination
 	Branches []*PostgresBranch `json:"data"`
 }
 
@@ -44,6 +105,10 @@ type CreatePostgresBranchRequest struct {
 	ClusterName  string         `json:"cluster_name,omitempty"`
 	MajorVersion string         `json:"major_version,omitempty"`
 	Storage      *StorageConfig `json:"storage,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // ListPostgresBranchesRequest encapsulates the request to list Postgres branches for a database.
@@ -59,6 +124,17 @@ type GetPostgresBranchRequest struct {
 	Branch       string
 }
 
+// WaitForPostgresBranchReadyRequest encapsulates the request for waiting
+// until a Postgres branch is ready.
+type WaitForPostgresBranchReadyRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+
+	// Progress, if set, is called with the branch after every poll.
+	Progress func(*PostgresBranch)
+}
+
 // DeletePostgresBranchRequest encapsulates the request to delete a Postgres branch.
 type DeletePostgresBranchRequest struct {
 	Organization      string
@@ -90,8 +166,8 @@ type ResizePostgresBranchRequest struct {
 // PostgresBranchClusterResizeRequest represents an asynchronous Postgres branch
 // cluster change (resize) request.
 type PostgresBranchClusterResizeRequest struct {
-	ID    string `json:"id"`
-	State string `json:"state"`
+	ID    string      `json:"id"`
+	State ResizeState `json:"state"`
 
 	ClusterName        string `json:"cluster_name"`
 	ClusterDisplayName string `json:"cluster_display_name"`
@@ -113,8 +189,10 @@ type PostgresBranchClusterResizeRequest struct {
 	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
-// Terminal states for a PostgresBranchClusterResizeRequest. Non-terminal
-// states are "queued", "pending", and "resizing".
+// Terminal states for a PostgresBranchClusterResizeRequest. They predate
+// ResizeState and stay untyped, so they compare with both ResizeState
+// values and strings. The State field itself is a ResizeState; convert it
+// with string(r.State) where a string is needed.
 const (
 	PostgresBranchChangeStateCompleted = "completed"
 	PostgresBranchChangeStateCanceled  = "canceled"
@@ -122,7 +200,7 @@ const (
 
 // Finished reports whether the change request reached a terminal state.
 func (r *PostgresBranchClusterResizeRequest) Finished() bool {
-	return r.State == PostgresBranchChangeStateCompleted || r.State == PostgresBranchChangeStateCanceled
+	return r.State.IsTerminal()
 }
 
 // ListPostgresBranchChangesRequest encapsulates the request to list change
@@ -221,7 +299,9 @@ type postgresBranchSchemaResponse struct {
 type PostgresBranchesService interface {
 	Create(context.Context, *CreatePostgresBranchRequest) (*PostgresBranch, error)
 	List(context.Context, *ListPostgresBranchesRequest, ...ListOption) ([]*PostgresBranch, error)
+	All(context.Context, *ListPostgresBranchesRequest, ...ListOption) iter.Seq2[*PostgresBranch, error]
 	Get(context.Context, *GetPostgresBranchRequest) (*PostgresBranch, error)
+	WaitForBranchReady(context.Context, *WaitForPostgresBranchReadyRequest, ...WaitOption) (*PostgresBranch, error)
 	Delete(context.Context, *DeletePostgresBranchRequest) error
 	Schema(context.Context, *PostgresBranchSchemaRequest) ([]*PostgresBranchSchema, error)
 	ListClusterSKUs(context.Context, *ListBranchClusterSKUsRequest, ...ListOption) ([]*ClusterSKU, error)
@@ -229,6 +309,7 @@ type PostgresBranchesService interface {
 	ListChanges(context.Context, *ListPostgresBranchChangesRequest) ([]*PostgresBranchClusterResizeRequest, error)
 	GetChange(context.Context, *GetPostgresBranchChangeRequest) (*PostgresBranchClusterResizeRequest, error)
 	CancelChanges(context.Context, *CancelPostgresBranchChangesRequest) error
+	WaitForChange(context.Context, *WaitForPostgresBranchChangeRequest, ...WaitOption) (*PostgresBranchClusterResizeRequest, error)
 	ListParameters(context.Context, *ListPostgresParametersRequest) ([]*PostgresParameter, error)
 }
 
@@ -247,7 +328,7 @@ func NewPostgresBranchesService(client *Client) *postgresBranchesService {
 // Create creates a new Postgres branch in the specified organization and database.
 func (p *postgresBranchesService) Create(ctx context.Context, createReq *CreatePostgresBranchRequest) (*PostgresBranch, error) {
 	path := postgresBranchesAPIPath(createReq.Organization, createReq.Database)
-	req, err := p.client.newRequest(http.MethodPost, path, createReq)
+	req, err := p.client.newRequest(http.MethodPost, path, createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("PostgresBranches", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -272,7 +353,7 @@ func (p *postgresBranchesService) List(ctx context.Context, listReq *ListPostgre
 		}
 	}
 
-	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("PostgresBranches", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -281,14 +362,23 @@ func (p *postgresBranchesService) List(ctx context.Context, listReq *ListPostgre
 	if err := p.client.do(ctx, req, &pgBranches); err != nil {
 		return nil, err
 	}
+	defaultOpts.setPagination(pgBranches.Pagination)
 
 	return pgBranches.Branches, nil
 }
 
+// All returns an iterator over all branches of a Postgres database, fetching
+// pages as needed.
+func (p *postgresBranchesService) All(ctx context.Context, listReq *ListPostgresBranchesRequest, opts ...ListOption) iter.Seq2[*PostgresBranch, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*PostgresBranch, error) {
+		return p.List(ctx, listReq, opts...)
+	})
+}
+
 // Get returns a single Postgres branch for the specified organization, database, and branch.
 func (p *postgresBranchesService) Get(ctx context.Context, getReq *GetPostgresBranchRequest) (*PostgresBranch, error) {
 	path := path.Join(postgresBranchesAPIPath(getReq.Organization, getReq.Database), getReq.Branch)
-	req, err := p.client.newRequest(http.MethodGet, path, nil)
+	req, err := p.client.newRequest(http.MethodGet, path, nil, withOperation("PostgresBranches", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -301,11 +391,45 @@ func (p *postgresBranchesService) Get(ctx context.Context, getReq *GetPostgresBr
 	return pgBranch, nil
 }
 
+// WaitForBranchReady polls the Postgres branch until it is ready, and
+// returns it. It fails with a *StateError if the branch fails or falls
+// asleep, since it won't become ready on its own. Transient errors, such as
+// a branch that isn't found right after it was created, are tolerated as set
+// with WithErrorTolerance. If ctx is done first, it returns ctx's error along
+// with the last branch seen.
+func (p *postgresBranchesService) WaitForBranchReady(ctx context.Context, waitReq *WaitForPostgresBranchReadyRequest, opts ...WaitOption) (*PostgresBranch, error) {
+	getReq := &GetPostgresBranchRequest{
+		Organization: waitReq.Organization,
+		Database:     waitReq.Database,
+		Branch:       waitReq.Branch,
+	}
+
+	var branch *PostgresBranch
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		b, err := p.Get(ctx, getReq)
+		if err != nil {
+			return false, err
+		}
+		branch = b
+		if waitReq.Progress != nil {
+			waitReq.Progress(b)
+		}
+		if b.Ready {
+			return true, nil
+		}
+		if b.State.IsFailed() || b.State == PostgresBranchSleeping {
+			return false, &StateError{Resource: "postgres branch " + waitReq.Branch, State: string(b.State)}
+		}
+		return false, nil
+	})
+	return branch, err
+}
+
 // Delete deletes a Postgres branch from the specified organization and database.
 func (p *postgresBranchesService) Delete(ctx context.Context, deleteReq *DeletePostgresBranchRequest) error {
 	path := path.Join(postgresBranchesAPIPath(deleteReq.Organization, deleteReq.Database), deleteReq.Branch)
 
-	var opts []RequestOption
+	opts := []RequestOption{withOperation("PostgresBranches", "Delete")}
 	if deleteReq.DeleteDescendants {
 		v := url.Values{}
 		v.Set("delete_descendants", "true")
@@ -333,7 +457,7 @@ func (p *postgresBranchesService) ListClusterSKUs(ctx context.Context, listReq *
 		}
 	}
 
-	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("PostgresBranches", "ListClusterSKUs"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -353,7 +477,7 @@ func (p *postgresBranchesService) ListClusterSKUs(ctx context.Context, listReq *
 // (the API responds 204 No Content).
 func (p *postgresBranchesService) Resize(ctx context.Context, resizeReq *ResizePostgresBranchRequest) (*PostgresBranchClusterResizeRequest, error) {
 	path := path.Join(postgresBranchAPIPath(resizeReq.Organization, resizeReq.Database, resizeReq.Branch), "changes")
-	req, err := p.client.newRequest(http.MethodPatch, path, resizeReq)
+	req, err := p.client.newRequest(http.MethodPatch, path, resizeReq, withOperation("PostgresBranches", "Resize"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -377,7 +501,7 @@ func (p *postgresBranchesService) Resize(ctx context.Context, resizeReq *ResizeP
 // most recent first.
 func (p *postgresBranchesService) ListChanges(ctx context.Context, listReq *ListPostgresBranchChangesRequest) ([]*PostgresBranchClusterResizeRequest, error) {
 	path := path.Join(postgresBranchAPIPath(listReq.Organization, listReq.Database, listReq.Branch), "changes")
-	req, err := p.client.newRequest(http.MethodGet, path, nil)
+	req, err := p.client.newRequest(http.MethodGet, path, nil, withOperation("PostgresBranches", "ListChanges"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -393,7 +517,7 @@ func (p *postgresBranchesService) ListChanges(ctx context.Context, listReq *List
 // GetChange returns a single change request for the specified Postgres branch.
 func (p *postgresBranchesService) GetChange(ctx context.Context, getReq *GetPostgresBranchChangeRequest) (*PostgresBranchClusterResizeRequest, error) {
 	path := path.Join(postgresBranchAPIPath(getReq.Organization, getReq.Database, getReq.Branch), "changes", getReq.ID)
-	req, err := p.client.newRequest(http.MethodGet, path, nil)
+	req, err := p.client.newRequest(http.MethodGet, path, nil, withOperation("PostgresBranches", "GetChange"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -410,7 +534,7 @@ func (p *postgresBranchesService) GetChange(ctx context.Context, getReq *GetPost
 // branch.
 func (p *postgresBranchesService) CancelChanges(ctx context.Context, cancelReq *CancelPostgresBranchChangesRequest) error {
 	path := path.Join(postgresBranchAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.Branch), "changes")
-	req, err := p.client.newRequest(http.MethodDelete, path, nil)
+	req, err := p.client.newRequest(http.MethodDelete, path, nil, withOperation("PostgresBranches", "CancelChanges"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -432,7 +556,7 @@ func (p *postgresBranchesService) ListParameters(ctx context.Context, listReq *L
 		v.Set("internal", fmt.Sprintf("%t", *listReq.Internal))
 	}
 
-	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(v))
+	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(v), withOperation("PostgresBranches", "ListParameters"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -455,7 +579,7 @@ func (p *postgresBranchesService) Schema(ctx context.Context, schemaReq *Postgre
 		v.Set("namespace", schemaReq.Namespace)
 	}
 
-	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(v))
+	req, err := p.client.newRequest(http.MethodGet, path, nil, WithQueryParams(v), withOperation("PostgresBranches", "Schema"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
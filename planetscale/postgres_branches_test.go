@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -362,7 +363,7 @@ func TestPostgresBranches_Resize(t *testing.T) {
 
 	c.Assert(err, qt.IsNil)
 	c.Assert(change.ID, qt.Equals, "resize-1")
-	c.Assert(change.State, qt.Equals, "queued")
+	c.Assert(change.State, qt.Equals, ResizeQueued)
 	c.Assert(change.ClusterName, qt.Equals, "PS_10_GCP_X86")
 	c.Assert(change.PreviousClusterName, qt.Equals, "PS_5_GCP_X86")
 }
@@ -493,7 +494,7 @@ func TestPostgresBranches_GetChange(t *testing.T) {
 
 	c.Assert(err, qt.IsNil)
 	c.Assert(change.ID, qt.Equals, "change-1")
-	c.Assert(change.State, qt.Equals, "resizing")
+	c.Assert(change.State, qt.Equals, ResizeResizing)
 	c.Assert(change.Finished(), qt.IsFalse)
 }
 
@@ -666,3 +667,57 @@ func TestPostgresBranches_CreateWithStorage(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(branch, qt.DeepEquals, want)
 }
+
+func TestPostgresBranches_WaitForBranchReady(t *testing.T) {
+	c := qt.New(t)
+
+	states := []string{"pending", "pending", "ready"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.URL.Path, qt.Equals, "/v1/organizations/my-org/databases/my-db/branches/"+testPostgresBranch)
+		state := states[0]
+		states = states[1:]
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "pg-1", "name": "` + testPostgresBranch + `", "state": "` + state + `", "ready": ` + fmt.Sprint(state == "ready") + `}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var seen []PostgresBranchState
+	branch, err := client.PostgresBranches.WaitForBranchReady(context.Background(), &WaitForPostgresBranchReadyRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       testPostgresBranch,
+		Progress: func(b *PostgresBranch) {
+			seen = append(seen, b.State)
+		},
+	}, WithPollInterval(time.Millisecond, time.Millisecond))
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Ready, qt.IsTrue)
+	c.Assert(seen, qt.DeepEquals, []PostgresBranchState{PostgresBranchPending, PostgresBranchPending, PostgresBranchReady})
+}
+
+func TestPostgresBranches_WaitForBranchReadySleeping(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "pg-1", "name": "` + testPostgresBranch + `", "state": "sleeping", "ready": false}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	branch, err := client.PostgresBranches.WaitForBranchReady(context.Background(), &WaitForPostgresBranchReadyRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       testPostgresBranch,
+	}, WithPollInterval(time.Millisecond, time.Millisecond))
+	var stateErr *StateError
+	c.Assert(errors.As(err, &stateErr), qt.IsTrue)
+	c.Assert(stateErr.State, qt.Equals, "sleeping")
+	c.Assert(err, qt.ErrorMatches, "postgres branch "+testPostgresBranch+" is in state sleeping")
+	c.Assert(branch.State, qt.Equals, PostgresBranchSleeping)
+}
@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -22,6 +23,7 @@ type PostgresCIDR struct {
 }
 
 type postgresCIDRsResponse struct {
+	Pagination
 	CIDRs []*PostgresCIDR `json:"data"`
 }
 
@@ -46,6 +48,10 @@ type CreatePostgresCIDRRequest struct {
 	Role         string   `json:"role,omitempty"`
 	CIDRs        []string `json:"cidrs"`
 	Description  string   `json:"description,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // UpdatePostgresCIDRRequest encapsulates updating an IP restriction entry.
@@ -70,6 +76,7 @@ type DeletePostgresCIDRRequest struct {
 // PostgresCIDRsService is an interface for the PlanetScale Postgres IP restriction API.
 type PostgresCIDRsService interface {
 	List(context.Context, *ListPostgresCIDRsRequest, ...ListOption) ([]*PostgresCIDR, error)
+	All(context.Context, *ListPostgresCIDRsRequest, ...ListOption) iter.Seq2[*PostgresCIDR, error]
 	Get(context.Context, *GetPostgresCIDRRequest) (*PostgresCIDR, error)
 	Create(context.Context, *CreatePostgresCIDRRequest) (*PostgresCIDR, error)
 	Update(context.Context, *UpdatePostgresCIDRRequest) (*PostgresCIDR, error)
@@ -95,7 +102,7 @@ func (s *postgresCIDRsService) List(ctx context.Context, listReq *ListPostgresCI
 		}
 	}
 
-	req, err := s.client.newRequest(http.MethodGet, postgresCIDRsAPIPath(listReq.Organization, listReq.Database), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, postgresCIDRsAPIPath(listReq.Organization, listReq.Database), nil, WithQueryParams(*listOpts.URLValues), withOperation("PostgresCIDRs", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list postgres cidrs: %w", err)
 	}
@@ -104,12 +111,21 @@ func (s *postgresCIDRsService) List(ctx context.Context, listReq *ListPostgresCI
 	if err := s.client.do(ctx, req, &resp); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(resp.Pagination)
 
 	return resp.CIDRs, nil
 }
 
+// All returns an iterator over all IP restriction entries of a Postgres
+// database, fetching pages as needed.
+func (s *postgresCIDRsService) All(ctx context.Context, listReq *ListPostgresCIDRsRequest, opts ...ListOption) iter.Seq2[*PostgresCIDR, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*PostgresCIDR, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 func (s *postgresCIDRsService) Get(ctx context.Context, getReq *GetPostgresCIDRRequest) (*PostgresCIDR, error) {
-	req, err := s.client.newRequest(http.MethodGet, postgresCIDRAPIPath(getReq.Organization, getReq.Database, getReq.ID), nil)
+	req, err := s.client.newRequest(http.MethodGet, postgresCIDRAPIPath(getReq.Organization, getReq.Database, getReq.ID), nil, withOperation("PostgresCIDRs", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for get postgres cidr: %w", err)
 	}
@@ -123,7 +139,7 @@ func (s *postgresCIDRsService) Get(ctx context.Context, getReq *GetPostgresCIDRR
 }
 
 func (s *postgresCIDRsService) Create(ctx context.Context, createReq *CreatePostgresCIDRRequest) (*PostgresCIDR, error) {
-	req, err := s.client.newRequest(http.MethodPost, postgresCIDRsAPIPath(createReq.Organization, createReq.Database), createReq)
+	req, err := s.client.newRequest(http.MethodPost, postgresCIDRsAPIPath(createReq.Organization, createReq.Database), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("PostgresCIDRs", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for create postgres cidr: %w", err)
 	}
@@ -137,7 +153,7 @@ func (s *postgresCIDRsService) Create(ctx context.Context, createReq *CreatePost
 }
 
 func (s *postgresCIDRsService) Update(ctx context.Context, updateReq *UpdatePostgresCIDRRequest) (*PostgresCIDR, error) {
-	req, err := s.client.newRequest(http.MethodPatch, postgresCIDRAPIPath(updateReq.Organization, updateReq.Database, updateReq.ID), updateReq)
+	req, err := s.client.newRequest(http.MethodPatch, postgresCIDRAPIPath(updateReq.Organization, updateReq.Database, updateReq.ID), updateReq, withOperation("PostgresCIDRs", "Update"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for update postgres cidr: %w", err)
 	}
@@ -151,7 +167,7 @@ func (s *postgresCIDRsService) Update(ctx context.Context, updateReq *UpdatePost
 }
 
 func (s *postgresCIDRsService) Delete(ctx context.Context, deleteReq *DeletePostgresCIDRRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, postgresCIDRAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.ID), nil)
+	req, err := s.client.newRequest(http.MethodDelete, postgresCIDRAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.ID), nil, withOperation("PostgresCIDRs", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating request for delete postgres cidr: %w", err)
 	}
@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -22,6 +23,7 @@ type PostgresRole struct {
 }
 
 type postgresRolesResponse struct {
+	Pagination
 	Roles []*PostgresRole `json:"data"`
 }
 
@@ -49,6 +51,10 @@ type CreatePostgresRoleRequest struct {
 	TTL             int      `json:"ttl,omitempty"`
 	InheritedRoles  []string `json:"inherited_roles,omitempty"`
 	WithReplication bool     `json:"with_replication,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // UpdatePostgresRoleRequest encapsulates the request for updating a role name for a database branch.
@@ -104,6 +110,7 @@ type ReassignPostgresRoleObjectsRequest struct {
 // PostgresRolesService defines the interface for managing PostgreSQL roles in PlanetScale.
 type PostgresRolesService interface {
 	List(context.Context, *ListPostgresRolesRequest, ...ListOption) ([]*PostgresRole, error)
+	All(context.Context, *ListPostgresRolesRequest, ...ListOption) iter.Seq2[*PostgresRole, error]
 	Get(context.Context, *GetPostgresRoleRequest) (*PostgresRole, error)
 	Create(context.Context, *CreatePostgresRoleRequest) (*PostgresRole, error)
 	Update(context.Context, *UpdatePostgresRoleRequest) (*PostgresRole, error)
@@ -129,7 +136,7 @@ func NewPostgresRolesService(client *Client) *postgresRolesService {
 // ResetDefaultRole resets the default role for a PostgreSQL database branch.
 func (p *postgresRolesService) ResetDefaultRole(ctx context.Context, resetReq *ResetDefaultRoleRequest) (*PostgresRole, error) {
 	pathStr := path.Join(postgresBranchRolesAPIPath(resetReq.Organization, resetReq.Database, resetReq.Branch), "reset-default")
-	req, err := p.client.newRequest(http.MethodPost, pathStr, resetReq)
+	req, err := p.client.newRequest(http.MethodPost, pathStr, resetReq, withOperation("PostgresRoles", "ResetDefaultRole"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -154,7 +161,7 @@ func (p *postgresRolesService) List(ctx context.Context, listReq *ListPostgresRo
 		}
 	}
 
-	req, err := p.client.newRequest(http.MethodGet, pathStr, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := p.client.newRequest(http.MethodGet, pathStr, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("PostgresRoles", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request to list roles: %w", err)
 	}
@@ -163,14 +170,23 @@ func (p *postgresRolesService) List(ctx context.Context, listReq *ListPostgresRo
 	if err := p.client.do(ctx, req, &rolesResp); err != nil {
 		return nil, err
 	}
+	defaultOpts.setPagination(rolesResp.Pagination)
 
 	return rolesResp.Roles, nil
 }
 
+// All returns an iterator over all roles of a Postgres branch, fetching pages
+// as needed.
+func (p *postgresRolesService) All(ctx context.Context, listReq *ListPostgresRolesRequest, opts ...ListOption) iter.Seq2[*PostgresRole, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*PostgresRole, error) {
+		return p.List(ctx, listReq, opts...)
+	})
+}
+
 // Get an existing role for a database branch.
 func (p *postgresRolesService) Get(ctx context.Context, getReq *GetPostgresRoleRequest) (*PostgresRole, error) {
 	pathStr := postgresBranchRoleAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.RoleId)
-	req, err := p.client.newRequest(http.MethodGet, pathStr, nil)
+	req, err := p.client.newRequest(http.MethodGet, pathStr, nil, withOperation("PostgresRoles", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -186,7 +202,7 @@ func (p *postgresRolesService) Get(ctx context.Context, getReq *GetPostgresRoleR
 // Create role credentials for a database branch.
 func (p *postgresRolesService) Create(ctx context.Context, createReq *CreatePostgresRoleRequest) (*PostgresRole, error) {
 	pathStr := postgresBranchRolesAPIPath(createReq.Organization, createReq.Database, createReq.Branch)
-	req, err := p.client.newRequest(http.MethodPost, pathStr, createReq)
+	req, err := p.client.newRequest(http.MethodPost, pathStr, createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("PostgresRoles", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -202,7 +218,7 @@ func (p *postgresRolesService) Create(ctx context.Context, createReq *CreatePost
 // Update role name for a database branch.
 func (p *postgresRolesService) Update(ctx context.Context, updateReq *UpdatePostgresRoleRequest) (*PostgresRole, error) {
 	pathStr := postgresBranchRoleAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.RoleId)
-	req, err := p.client.newRequest(http.MethodPatch, pathStr, updateReq)
+	req, err := p.client.newRequest(http.MethodPatch, pathStr, updateReq, withOperation("PostgresRoles", "Update"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -218,7 +234,7 @@ func (p *postgresRolesService) Update(ctx context.Context, updateReq *UpdatePost
 // Renew role expiration for a database branch.
 func (p *postgresRolesService) Renew(ctx context.Context, renewReq *RenewPostgresRoleRequest) (*PostgresRole, error) {
 	pathStr := postgresBranchRoleRenewAPIPath(renewReq.Organization, renewReq.Database, renewReq.Branch, renewReq.RoleId)
-	req, err := p.client.newRequest(http.MethodPost, pathStr, renewReq)
+	req, err := p.client.newRequest(http.MethodPost, pathStr, renewReq, withOperation("PostgresRoles", "Renew"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -234,7 +250,7 @@ func (p *postgresRolesService) Renew(ctx context.Context, renewReq *RenewPostgre
 // Delete role credentials for a database branch.
 func (p *postgresRolesService) Delete(ctx context.Context, deleteReq *DeletePostgresRoleRequest) error {
 	pathStr := postgresBranchRoleAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.RoleId)
-	req, err := p.client.newRequest(http.MethodDelete, pathStr, deleteReq)
+	req, err := p.client.newRequest(http.MethodDelete, pathStr, deleteReq, withOperation("PostgresRoles", "Delete"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -246,7 +262,7 @@ func (p *postgresRolesService) Delete(ctx context.Context, deleteReq *DeletePost
 // ResetPassword resets a role's password for a database branch.
 func (p *postgresRolesService) ResetPassword(ctx context.Context, resetReq *ResetPostgresRolePasswordRequest) (*PostgresRole, error) {
 	pathStr := postgresBranchRoleResetPasswordAPIPath(resetReq.Organization, resetReq.Database, resetReq.Branch, resetReq.RoleId)
-	req, err := p.client.newRequest(http.MethodPost, pathStr, resetReq)
+	req, err := p.client.newRequest(http.MethodPost, pathStr, resetReq, withOperation("PostgresRoles", "ResetPassword"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -262,7 +278,7 @@ func (p *postgresRolesService) ResetPassword(ctx context.Context, resetReq *Rese
 // ReassignObjects reassigns objects owned by one role to another role.
 func (p *postgresRolesService) ReassignObjects(ctx context.Context, reassignReq *ReassignPostgresRoleObjectsRequest) error {
 	pathStr := postgresBranchRoleReassignObjectsAPIPath(reassignReq.Organization, reassignReq.Database, reassignReq.Branch, reassignReq.RoleId)
-	req, err := p.client.newRequest(http.MethodPost, pathStr, reassignReq)
+	req, err := p.client.newRequest(http.MethodPost, pathStr, reassignReq, withOperation("PostgresRoles", "ReassignObjects"))
 	if err != nil {
 		return fmt.Errorf("error creating http request: %w", err)
 	}
@@ -100,7 +100,7 @@ func (s *processlistService) List(ctx context.Context, req *ProcesslistRequest)
 		v.Set("shard", req.Shard)
 	}
 
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Processlist", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -116,7 +116,7 @@ func (s *processlistService) List(ctx context.Context, req *ProcesslistRequest)
 func (s *processlistService) Kill(ctx context.Context, req *KillProcessRequest) (*KillProcessResult, error) {
 	p := path.Join(processlistAPIPath(req.Organization, req.Database, req.Branch), "kill")
 
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Processlist", "Kill"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
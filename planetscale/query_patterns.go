@@ -14,13 +14,48 @@ import (
 
 // QueryPatternsReport represents a query patterns report for a branch.
 type QueryPatternsReport struct {
-	PublicID    string    `json:"id"`
-	State       string    `json:"state"`
-	Actor       *Actor    `json:"actor"`
-	URL         string    `json:"url"`
-	DownloadURL string    `json:"download_url"`
-	CreatedAt   time.Time `json:"created_at"`
-	FinishedAt  time.Time `json:"finished_at"`
+	PublicID    string                   `json:"id"`
+	State       QueryPatternsReportState `json:"state"`
+	Actor       *Actor                   `json:"actor"`
+	URL         string                   `json:"url"`
+	DownloadURL string                   `json:"download_url"`
+	CreatedAt   time.Time                `json:"created_at"`
+	FinishedAt  time.Time                `json:"finished_at"`
+}
+
+// QueryPatternsReportState is the state of a query patterns report.
+type QueryPatternsReportState string
+
+const (
+	QueryPatternsReportPending   QueryPatternsReportState = "pending"
+	QueryPatternsReportCompleted QueryPatternsReportState = "completed"
+	QueryPatternsReportFailed    QueryPatternsReportState = "failed"
+)
+
+var queryPatternsReportStates = map[QueryPatternsReportState]stateClass{
+	QueryPatternsReportPending:   stateInProgress,
+	QueryPatternsReportCompleted: stateFinished,
+	QueryPatternsReportFailed:    stateFailed,
+}
+
+// IsKnown reports whether this package declares s.
+func (s QueryPatternsReportState) IsKnown() bool {
+	return queryPatternsReportStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the report finished, successfully or not.
+func (s QueryPatternsReportState) IsTerminal() bool {
+	return queryPatternsReportStates[s].terminal()
+}
+
+// IsFailed reports whether the report couldn't be generated.
+func (s QueryPatternsReportState) IsFailed() bool {
+	return queryPatternsReportStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the report is being generated.
+func (s QueryPatternsReportState) IsInProgress() bool {
+	return queryPatternsReportStates[s] == stateInProgress
 }
 
 type CreateQueryPatternsReportRequest struct {
@@ -66,7 +101,7 @@ func NewQueryPatternsService(client *Client) *queryPatternsService {
 // CreateReport starts generating a new query patterns report for a branch.
 func (s *queryPatternsService) CreateReport(ctx context.Context, createReq *CreateQueryPatternsReportRequest) (*QueryPatternsReport, error) {
 	path := queryPatternsAPIPath(createReq.Organization, createReq.Database, createReq.Branch)
-	req, err := s.client.newRequest(http.MethodPost, path, nil)
+	req, err := s.client.newRequest(http.MethodPost, path, nil, withOperation("QueryPatterns", "CreateReport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -82,7 +117,7 @@ func (s *queryPatternsService) CreateReport(ctx context.Context, createReq *Crea
 // GetReport returns a single query patterns report for a branch.
 func (s *queryPatternsService) GetReport(ctx context.Context, getReq *GetQueryPatternsReportRequest) (*QueryPatternsReport, error) {
 	path := queryPatternsReportAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.Report)
-	req, err := s.client.newRequest(http.MethodGet, path, nil)
+	req, err := s.client.newRequest(http.MethodGet, path, nil, withOperation("QueryPatterns", "GetReport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -99,7 +134,7 @@ func (s *queryPatternsService) GetReport(ctx context.Context, getReq *GetQueryPa
 // The caller must close the returned io.ReadCloser.
 func (s *queryPatternsService) DownloadReport(ctx context.Context, downloadReq *DownloadQueryPatternsReportRequest) (io.ReadCloser, error) {
 	reqPath := path.Join(queryPatternsReportAPIPath(downloadReq.Organization, downloadReq.Database, downloadReq.Branch, downloadReq.Report), "download")
-	req, err := s.client.newRequest(http.MethodGet, reqPath, nil)
+	req, err := s.client.newRequest(http.MethodGet, reqPath, nil, withOperation("QueryPatterns", "DownloadReport"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -0,0 +1,148 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimitPolicy configures the client-side rate limiter installed with
+// WithRateLimit.
+type RateLimitPolicy struct {
+	// RequestsPerSecond is the sustained rate of requests the client sends.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that can be sent at once before the
+	// sustained rate applies. Defaults to 1.
+	Burst int
+
+	// Adaptive pauses all requests when the API reports that the current
+	// rate-limit window is exhausted, either with a zero
+	// X-RateLimit-Remaining header or with a 429 response, until the window
+	// resets.
+	Adaptive bool
+}
+
+// WithRateLimit installs a token-bucket rate limiter shared by every service
+// of the client. Each HTTP request, including retries, waits for a token
+// before it is sent. Waiting respects the context of the call.
+func WithRateLimit(policy RateLimitPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy.RequestsPerSecond <= 0 {
+			return errors.New("rate limit requests per second must be positive")
+		}
+		if policy.Burst < 0 {
+			return errors.New("rate limit burst must not be negative")
+		}
+		if policy.Burst == 0 {
+			policy.Burst = 1
+		}
+
+		c.rateLimiter = newRateLimiter(policy, time.Now())
+		return nil
+	}
+}
+
+type rateLimiter struct {
+	mu sync.Mutex
+
+	rate     float64
+	burst    float64
+	adaptive bool
+
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newRateLimiter(policy RateLimitPolicy, now time.Time) *rateLimiter {
+	return &rateLimiter{
+		rate:     policy.RequestsPerSecond,
+		burst:    float64(policy.Burst),
+		adaptive: policy.Adaptive,
+		tokens:   float64(policy.Burst),
+		last:     now,
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before
+// using it. The token count may go negative, which queues callers in order.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if pause := l.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// cancel returns a token taken by reserve that was not used.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// wait blocks until a request may be sent or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// observe adapts the limiter to the rate-limit state reported by the API.
+func (l *rateLimiter) observe(meta *ResponseMetadata, now time.Time) {
+	if !l.adaptive {
+		return
+	}
+
+	var until time.Time
+	switch {
+	case meta.StatusCode == http.StatusTooManyRequests:
+		if retryAfter := parseRetryAfter(meta.Header.Get("Retry-After")); retryAfter > 0 {
+			until = now.Add(retryAfter)
+		} else {
+			until = meta.RateLimit.Reset
+		}
+	case meta.RateLimit.Limit > 0 && meta.RateLimit.Remaining == 0:
+		until = meta.RateLimit.Reset
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
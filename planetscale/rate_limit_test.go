@@ -0,0 +1,92 @@
+package planetscale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	c := qt.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	l := newRateLimiter(RateLimitPolicy{RequestsPerSecond: 2, Burst: 2}, now)
+	c.Assert(l.reserve(now), qt.Equals, time.Duration(0))
+	c.Assert(l.reserve(now), qt.Equals, time.Duration(0))
+	c.Assert(l.reserve(now), qt.Equals, 500*time.Millisecond)
+	c.Assert(l.reserve(now), qt.Equals, time.Second)
+
+	// Tokens refill at the configured rate, never above burst.
+	later := now.Add(time.Minute)
+	c.Assert(l.reserve(later), qt.Equals, time.Duration(0))
+	c.Assert(l.reserve(later), qt.Equals, time.Duration(0))
+	c.Assert(l.reserve(later), qt.Equals, 500*time.Millisecond)
+}
+
+func TestRateLimiter_Adaptive(t *testing.T) {
+	c := qt.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	l := newRateLimiter(RateLimitPolicy{RequestsPerSecond: 100, Burst: 10, Adaptive: true}, now)
+	l.observe(&ResponseMetadata{
+		StatusCode: http.StatusOK,
+		RateLimit:  RateLimit{Limit: 600, Remaining: 0, Reset: now.Add(10 * time.Second)},
+		Header:     http.Header{},
+	}, now)
+	c.Assert(l.reserve(now), qt.Equals, 10*time.Second)
+
+	header := http.Header{}
+	header.Set("Retry-After", "30")
+	l.observe(&ResponseMetadata{StatusCode: http.StatusTooManyRequests, Header: header}, now)
+	c.Assert(l.reserve(now), qt.Equals, 30*time.Second)
+}
+
+func TestRateLimiter_NotAdaptive(t *testing.T) {
+	c := qt.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	l := newRateLimiter(RateLimitPolicy{RequestsPerSecond: 100, Burst: 10}, now)
+	l.observe(&ResponseMetadata{
+		StatusCode: http.StatusOK,
+		RateLimit:  RateLimit{Limit: 600, Remaining: 0, Reset: now.Add(10 * time.Second)},
+		Header:     http.Header{},
+	}, now)
+	c.Assert(l.reserve(now), qt.Equals, time.Duration(0))
+}
+
+func TestWithRateLimit_WaitRespectsContext(t *testing.T) {
+	c := qt.New(t)
+
+	hits := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithRateLimit(RateLimitPolicy{RequestsPerSecond: 0.01}))
+	c.Assert(err, qt.IsNil)
+
+	err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/api-endpoint"), nil)
+	c.Assert(err, qt.IsNil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = client.do(ctx, mustNewRequest(t, client, http.MethodGet, "/api-endpoint"), nil)
+	c.Assert(err, qt.ErrorIs, context.DeadlineExceeded)
+	c.Assert(hits, qt.Equals, 1)
+}
+
+func TestWithRateLimit_RejectsInvalidValues(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := NewClient(WithRateLimit(RateLimitPolicy{}))
+	c.Assert(err, qt.ErrorMatches, "rate limit requests per second must be positive")
+
+	_, err = NewClient(WithRateLimit(RateLimitPolicy{RequestsPerSecond: 1, Burst: -1}))
+	c.Assert(err, qt.ErrorMatches, "rate limit burst must not be negative")
+}
@@ -0,0 +1,49 @@
+package planetscale
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyError is returned by calls that change resources when the client
+// was created with WithReadOnly. No request is sent.
+type ReadOnlyError struct {
+	// Service and Method name the client method that was refused, e.g.
+	// "DatabaseBranches" and "Delete". They are empty for requests made with
+	// Client.Do.
+	Service string
+	Method  string
+
+	HTTPMethod string
+	Path       string
+}
+
+func (e *ReadOnlyError) Error() string {
+	call := e.HTTPMethod + " " + e.Path
+	if e.Service != "" {
+		call = e.Service + "." + e.Method + " (" + call + ")"
+	}
+	return fmt.Sprintf("read-only client refused %s", call)
+}
+
+// WithReadOnly configures the client to refuse every request other than a
+// GET with a *ReadOnlyError, before anything is sent. It guards reporting
+// tools against changing resources even when their token allows it. Refused
+// calls don't reach middleware or the journal.
+func WithReadOnly() ClientOption {
+	return func(c *Client) error {
+		c.readOnly = true
+		return nil
+	}
+}
+
+// refuseReadOnly returns a *ReadOnlyError for req.
+func refuseReadOnly(req *http.Request) error {
+	op := operationFromRequest(req)
+	return &ReadOnlyError{
+		Service:    op.Service,
+		Method:     op.Method,
+		HTTPMethod: req.Method,
+		Path:       req.URL.Path,
+	}
+}
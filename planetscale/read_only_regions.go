@@ -3,6 +3,7 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"strings"
@@ -27,12 +28,14 @@ type ListReadOnlyRegionsRequest struct {
 }
 
 type readOnlyRegionsResponse struct {
+	Pagination
 	Data []*ReadOnlyRegion `json:"data"`
 }
 
 // ReadOnlyRegionsService lists read-only regions for a database.
 type ReadOnlyRegionsService interface {
 	List(ctx context.Context, req *ListReadOnlyRegionsRequest, opts ...ListOption) ([]*ReadOnlyRegion, error)
+	All(context.Context, *ListReadOnlyRegionsRequest, ...ListOption) iter.Seq2[*ReadOnlyRegion, error]
 }
 
 type readOnlyRegionsService struct {
@@ -51,7 +54,7 @@ func (s *readOnlyRegionsService) List(ctx context.Context, listReq *ListReadOnly
 		}
 	}
 
-	req, err := s.client.newRequest(http.MethodGet, pathStr, nil, WithQueryParams(*defaultOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, pathStr, nil, WithQueryParams(*defaultOpts.URLValues), withOperation("ReadOnlyRegions", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list read-only regions: %w", err)
 	}
@@ -60,10 +63,19 @@ func (s *readOnlyRegionsService) List(ctx context.Context, listReq *ListReadOnly
 	if err := s.client.do(ctx, req, &resp); err != nil {
 		return nil, err
 	}
+	defaultOpts.setPagination(resp.Pagination)
 
 	return resp.Data, nil
 }
 
+// All returns an iterator over all read-only regions of a database, fetching
+// pages as needed.
+func (s *readOnlyRegionsService) All(ctx context.Context, listReq *ListReadOnlyRegionsRequest, opts ...ListOption) iter.Seq2[*ReadOnlyRegion, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*ReadOnlyRegion, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 // FindReadOnlyRegion matches a read-only region by public id, region slug, or display name.
 func FindReadOnlyRegion(regions []*ReadOnlyRegion, name string) (*ReadOnlyRegion, error) {
 	name = strings.TrimSpace(name)
@@ -0,0 +1,74 @@
+package planetscale
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestReadOnly(t *testing.T) {
+	c := qt.New(t)
+
+	var methods []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id": "branch-1", "name": "main"}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithReadOnly())
+	c.Assert(err, qt.IsNil)
+	ctx := context.Background()
+
+	_, err = client.DatabaseBranches.Get(ctx, &GetDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "main"})
+	c.Assert(err, qt.IsNil)
+
+	err = client.DatabaseBranches.Delete(ctx, &DeleteDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "main"})
+	var readOnlyErr *ReadOnlyError
+	c.Assert(errors.As(err, &readOnlyErr), qt.IsTrue)
+	c.Assert(readOnlyErr, qt.DeepEquals, &ReadOnlyError{
+		Service:    "DatabaseBranches",
+		Method:     "Delete",
+		HTTPMethod: http.MethodDelete,
+		Path:       "/v1/organizations/my-org/databases/my-db/branches/main",
+	})
+	c.Assert(err, qt.ErrorMatches, `read-only client refused DatabaseBranches.Delete \(DELETE /v1/organizations/my-org/databases/my-db/branches/main\)`)
+
+	err = client.Raw(ctx, http.MethodPost, "v1/organizations/my-org/databases", nil, nil)
+	c.Assert(err, qt.ErrorMatches, `read-only client refused POST /v1/organizations/my-org/databases`)
+
+	c.Assert(methods, qt.DeepEquals, []string{http.MethodGet})
+}
+
+func TestReadOnly_SkipsMiddlewareAndJournal(t *testing.T) {
+	c := qt.New(t)
+
+	var (
+		journal bytes.Buffer
+		ops     []string
+	)
+	client, err := NewClient(
+		WithBaseURL("http://127.0.0.1:0"),
+		WithReadOnly(),
+		WithJournal(NewJSONLinesJournal(&journal)),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, op *Operation, v interface{}) error {
+				ops = append(ops, op.Method)
+				return next(ctx, op, v)
+			}
+		}),
+	)
+	c.Assert(err, qt.IsNil)
+
+	err = client.DatabaseBranches.Delete(context.Background(), &DeleteDatabaseBranchRequest{Organization: "my-org", Database: "my-db", Branch: "main"})
+	var roErr *ReadOnlyError
+	c.Assert(err, qt.ErrorAs, &roErr)
+	c.Assert(ops, qt.HasLen, 0)
+	c.Assert(journal.Len(), qt.Equals, 0)
+}
@@ -0,0 +1,80 @@
+package planetscale
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// redacted replaces secret values in logs and recordings.
+const redacted = "REDACTED"
+
+// sensitiveFields are JSON keys whose values are credentials: password
+// plain texts, Postgres role passwords, webhook secrets, service token
+// values, import source passwords and keys, and OAuth tokens.
+var sensitiveFields = map[string]bool{
+	"plain_text":    true,
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+	"ssl_key":       true,
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+// sensitiveHeaders are headers that carry credentials.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// redactHeader returns a copy of h with credential headers replaced.
+func redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := out[name]; ok {
+			out.Set(name, redacted)
+		}
+	}
+	return out
+}
+
+// redactJSON returns body with the values of sensitive fields replaced, at
+// any depth. Bodies that are not valid JSON are returned as is with ok set
+// to false.
+func redactJSON(body []byte) (out []byte, ok bool) {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body, false
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return body, false
+	}
+	return out, true
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if sensitiveFields[k] {
+				if val != nil && val != "" {
+					v[k] = redacted
+				}
+				continue
+			}
+			v[k] = redactValue(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -40,7 +40,7 @@ func NewRegionsSevice(client *Client) *regionsService {
 }
 
 func (r *regionsService) List(ctx context.Context, listReq *ListRegionsRequest) ([]*Region, error) {
-	req, err := r.client.newRequest(http.MethodGet, regionsAPIPath, nil)
+	req, err := r.client.newRequest(http.MethodGet, regionsAPIPath, nil, withOperation("Regions", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request for list regions: %w", err)
 	}
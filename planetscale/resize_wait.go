@@ -0,0 +1,275 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// resizeCancelTimeout bounds the cancel call a resize waiter makes after its
+// context is done.
+const resizeCancelTimeout = 30 * time.Second
+
+// resizeWaiter describes how to follow one kind of resize request. The
+// WaitForResize and WaitForChange methods of the services only differ in the
+// calls they make, so they share waitForResize and with it the same
+// semantics.
+type resizeWaiter[T any] struct {
+	// resource names the resize in errors, e.g. "keyspace sharded resize".
+	resource string
+
+	status func(context.Context) (T, error)
+	state  func(T) ResizeState
+	cancel func(context.Context) error
+
+	progress     func(T)
+	cancelOnDone bool
+}
+
+// waitForResize polls w.status until the resize reaches a terminal state and
+// returns the last resize seen. A failed resize is reported with a
+// *StateError; a completed or canceled one is returned without error.
+//
+// If ctx is done before the resize finishes and w.cancelOnDone is set, the
+// resize is canceled before ctx's error is returned, unless it was last seen
+// finished.
+func waitForResize[T any](ctx context.Context, w *resizeWaiter[T], opts []WaitOption) (T, error) {
+	var (
+		last T
+		seen bool
+	)
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		r, err := w.status(ctx)
+		if err != nil {
+			return false, err
+		}
+		last, seen = r, true
+		if w.progress != nil {
+			w.progress(r)
+		}
+
+		state := w.state(r)
+		if state.IsFailed() {
+			return false, &StateError{Resource: w.resource, State: string(state)}
+		}
+		return state.IsTerminal(), nil
+	})
+	if err == nil {
+		return last, nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil && w.cancelOnDone {
+		if seen && w.state(last).IsTerminal() {
+			return last, ctxErr
+		}
+
+		// ctx is done, so the cancel call gets a context of its own that
+		// keeps ctx's values.
+		cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), resizeCancelTimeout)
+		defer cancel()
+		if err := w.cancel(cancelCtx); err != nil {
+			return last, errors.Join(ctxErr, fmt.Errorf("error canceling %s: %w", w.resource, err))
+		}
+		return last, ctxErr
+	}
+
+	return last, err
+}
+
+// WaitForBranchResizeRequest encapsulates the request for waiting until the
+// latest VTGate resize of a Vitess branch finishes.
+type WaitForBranchResizeRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+
+	// Progress, if set, is called with the resize after every poll.
+	Progress func(*BranchResizeRequest)
+
+	// CancelOnDone, if set, cancels the resize when ctx is done before the
+	// resize finishes.
+	CancelOnDone bool
+}
+
+// WaitForResize polls the latest VTGate resize of a Vitess branch until it
+// finishes and returns it. A canceled resize is returned without error; a
+// failed one is reported with a *StateError. If ctx is done first,
+// WaitForResize returns ctx's error along with the last resize seen.
+func (d *databaseBranchesService) WaitForResize(ctx context.Context, waitReq *WaitForBranchResizeRequest, opts ...WaitOption) (*BranchResizeRequest, error) {
+	return waitForResize(ctx, &resizeWaiter[*BranchResizeRequest]{
+		resource: fmt.Sprintf("branch %s resize", waitReq.Branch),
+		status: func(ctx context.Context) (*BranchResizeRequest, error) {
+			return d.ResizeStatus(ctx, &BranchResizeStatusRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+			})
+		},
+		state: func(r *BranchResizeRequest) ResizeState { return r.State },
+		cancel: func(ctx context.Context) error {
+			return d.CancelResize(ctx, &CancelBranchResizeRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+			})
+		},
+		progress:     waitReq.Progress,
+		cancelOnDone: waitReq.CancelOnDone,
+	}, opts)
+}
+
+// WaitForKeyspaceResizeRequest encapsulates the request for waiting until the
+// latest resize of a keyspace finishes.
+type WaitForKeyspaceResizeRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+	Keyspace     string
+
+	// Progress, if set, is called with the resize after every poll.
+	Progress func(*KeyspaceResizeRequest)
+
+	// CancelOnDone, if set, cancels the resize when ctx is done before the
+	// resize finishes.
+	CancelOnDone bool
+}
+
+// WaitForResize polls the latest resize of a keyspace until it finishes and
+// returns it. A canceled resize is returned without error; a failed one is
+// reported with a *StateError. If ctx is done first, WaitForResize returns
+// ctx's error along with the last resize seen.
+func (s *keyspacesService) WaitForResize(ctx context.Context, waitReq *WaitForKeyspaceResizeRequest, opts ...WaitOption) (*KeyspaceResizeRequest, error) {
+	return waitForResize(ctx, &resizeWaiter[*KeyspaceResizeRequest]{
+		resource: fmt.Sprintf("keyspace %s resize", waitReq.Keyspace),
+		status: func(ctx context.Context) (*KeyspaceResizeRequest, error) {
+			return s.ResizeStatus(ctx, &KeyspaceResizeStatusRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+				Keyspace:     waitReq.Keyspace,
+			})
+		},
+		state: func(r *KeyspaceResizeRequest) ResizeState { return r.State },
+		cancel: func(ctx context.Context) error {
+			return s.CancelResize(ctx, &CancelKeyspaceResizeRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+				Keyspace:     waitReq.Keyspace,
+			})
+		},
+		progress:     waitReq.Progress,
+		cancelOnDone: waitReq.CancelOnDone,
+	}, opts)
+}
+
+// WaitForPostgresBouncerResizeRequest encapsulates the request for waiting
+// until a resize of a dedicated PgBouncer finishes.
+type WaitForPostgresBouncerResizeRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+	Bouncer      string
+
+	// ID identifies the resize. If empty, the bouncer's latest resize is
+	// waited for.
+	ID string
+
+	// Progress, if set, is called with the resize after every poll.
+	Progress func(*PostgresBouncerResizeRequest)
+
+	// CancelOnDone, if set, cancels the bouncer's unfinished resizes when
+	// ctx is done before the resize finishes.
+	CancelOnDone bool
+}
+
+// WaitForResize polls a resize of a dedicated PgBouncer until it finishes and
+// returns it. A canceled resize is returned without error; a failed one is
+// reported with a *StateError. If ctx is done first, WaitForResize returns
+// ctx's error along with the last resize seen.
+func (s *postgresBouncersService) WaitForResize(ctx context.Context, waitReq *WaitForPostgresBouncerResizeRequest, opts ...WaitOption) (*PostgresBouncerResizeRequest, error) {
+	return waitForResize(ctx, &resizeWaiter[*PostgresBouncerResizeRequest]{
+		resource: fmt.Sprintf("bouncer %s resize", waitReq.Bouncer),
+		status: func(ctx context.Context) (*PostgresBouncerResizeRequest, error) {
+			resizes, err := s.ListResizes(ctx, &ListPostgresBouncerResizesRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+				Bouncer:      waitReq.Bouncer,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// Resizes are listed newest first.
+			for _, r := range resizes {
+				if waitReq.ID == "" || r.ID == waitReq.ID {
+					return r, nil
+				}
+			}
+			msg := fmt.Sprintf("no resize of bouncer %s found", waitReq.Bouncer)
+			if waitReq.ID != "" {
+				msg = fmt.Sprintf("resize %s of bouncer %s not found", waitReq.ID, waitReq.Bouncer)
+			}
+			return nil, &Error{
+				msg:  msg,
+				Code: ErrNotFound,
+			}
+		},
+		state: func(r *PostgresBouncerResizeRequest) ResizeState { return r.State },
+		cancel: func(ctx context.Context) error {
+			return s.CancelResizes(ctx, &CancelPostgresBouncerResizesRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+				Bouncer:      waitReq.Bouncer,
+			})
+		},
+		progress:     waitReq.Progress,
+		cancelOnDone: waitReq.CancelOnDone,
+	}, opts)
+}
+
+// WaitForPostgresBranchChangeRequest encapsulates the request for waiting
+// until a change request of a Postgres branch finishes.
+type WaitForPostgresBranchChangeRequest struct {
+	Organization string
+	Database     string
+	Branch       string
+	ID           string
+
+	// Progress, if set, is called with the change after every poll.
+	Progress func(*PostgresBranchClusterResizeRequest)
+
+	// CancelOnDone, if set, cancels the branch's queued changes when ctx is
+	// done before the change finishes.
+	CancelOnDone bool
+}
+
+// WaitForChange polls a change request of a Postgres branch until it finishes
+// and returns it. A canceled change is returned without error; a failed one
+// is reported with a *StateError. If ctx is done first, WaitForChange returns
+// ctx's error along with the last change seen.
+func (p *postgresBranchesService) WaitForChange(ctx context.Context, waitReq *WaitForPostgresBranchChangeRequest, opts ...WaitOption) (*PostgresBranchClusterResizeRequest, error) {
+	return waitForResize(ctx, &resizeWaiter[*PostgresBranchClusterResizeRequest]{
+		resource: fmt.Sprintf("postgres branch %s change %s", waitReq.Branch, waitReq.ID),
+		status: func(ctx context.Context) (*PostgresBranchClusterResizeRequest, error) {
+			return p.GetChange(ctx, &GetPostgresBranchChangeRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+				ID:           waitReq.ID,
+			})
+		},
+		state: func(r *PostgresBranchClusterResizeRequest) ResizeState { return r.State },
+		cancel: func(ctx context.Context) error {
+			return p.CancelChanges(ctx, &CancelPostgresBranchChangesRequest{
+				Organization: waitReq.Organization,
+				Database:     waitReq.Database,
+				Branch:       waitReq.Branch,
+			})
+		},
+		progress:     waitReq.Progress,
+		cancelOnDone: waitReq.CancelOnDone,
+	}, opts)
+}
@@ -0,0 +1,227 @@
+package planetscale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+// resizeServer serves responses in order on GET requests to path and counts
+// DELETE requests to it.
+func resizeServer(c *qt.C, path string, responses []string, cancels *atomic.Int32) *Client {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			cancels.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		c.Check(r.URL.Path, qt.Equals, path)
+		w.WriteHeader(http.StatusOK)
+		out := responses[0]
+		if len(responses) > 1 {
+			responses = responses[1:]
+		}
+		_, err := w.Write([]byte(out))
+		c.Check(err, qt.IsNil)
+	}))
+	c.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+	return client
+}
+
+var fastPoll = WithPollInterval(time.Millisecond, time.Millisecond)
+
+func TestResizes_WaitForKeyspaceResize(t *testing.T) {
+	c := qt.New(t)
+
+	var cancels atomic.Int32
+	client := resizeServer(c, "/v1/organizations/my-org/databases/my-db/branches/main/keyspaces/sharded/resizes", []string{
+		`{"data":[{"id":"r1","state":"pending"}]}`,
+		`{"data":[{"id":"r1","state":"resizing"}]}`,
+		`{"data":[{"id":"r1","state":"completed"}]}`,
+	}, &cancels)
+
+	var states []ResizeState
+	resize, err := client.Keyspaces.WaitForResize(context.Background(), &WaitForKeyspaceResizeRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Keyspace:     "sharded",
+		Progress: func(r *KeyspaceResizeRequest) {
+			states = append(states, r.State)
+		},
+		CancelOnDone: true,
+	}, fastPoll)
+	c.Assert(err, qt.IsNil)
+	c.Assert(resize.ID, qt.Equals, "r1")
+	c.Assert(resize.State, qt.Equals, ResizeCompleted)
+	c.Assert(states, qt.DeepEquals, []ResizeState{ResizePending, ResizeResizing, ResizeCompleted})
+	c.Assert(cancels.Load(), qt.Equals, int32(0))
+}
+
+func TestResizes_WaitForKeyspaceResizeDoneWithContext(t *testing.T) {
+	c := qt.New(t)
+
+	var cancels atomic.Int32
+	client := resizeServer(c, "/v1/organizations/my-org/databases/my-db/branches/main/keyspaces/sharded/resizes", []string{
+		`{"data":[{"id":"r1","state":"completed"}]}`,
+	}, &cancels)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// ctx is done by the time the completed resize is returned from poll.
+	resize, err := client.Keyspaces.WaitForResize(ctx, &WaitForKeyspaceResizeRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Keyspace:     "sharded",
+		Progress: func(*KeyspaceResizeRequest) {
+			cancel()
+		},
+		CancelOnDone: true,
+	}, fastPoll)
+	c.Assert(err, qt.IsNil)
+	c.Assert(resize.State, qt.Equals, ResizeCompleted)
+	c.Assert(cancels.Load(), qt.Equals, int32(0))
+}
+
+func TestResizes_WaitForBranchResize(t *testing.T) {
+	tests := []struct {
+		desc      string
+		final     string
+		wantState ResizeState
+		wantErr   string
+	}{
+		{
+			desc:      "canceled",
+			final:     `{"data":[{"id":"r1","state":"canceled"}]}`,
+			wantState: ResizeCanceled,
+		},
+		{
+			desc:      "failed",
+			final:     `{"data":[{"id":"r1","state":"failed"}]}`,
+			wantState: ResizeFailed,
+			wantErr:   "branch main resize is in state failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			var cancels atomic.Int32
+			client := resizeServer(c, "/v1/organizations/my-org/databases/my-db/branches/main/resizes", []string{
+				`{"data":[{"id":"r1","state":"queued"}]}`,
+				tt.final,
+			}, &cancels)
+
+			resize, err := client.DatabaseBranches.WaitForResize(context.Background(), &WaitForBranchResizeRequest{
+				Organization: "my-org",
+				Database:     "my-db",
+				Branch:       "main",
+			}, fastPoll)
+			if tt.wantErr != "" {
+				c.Assert(err, qt.ErrorMatches, tt.wantErr)
+				var stateErr *StateError
+				c.Assert(err, qt.ErrorAs, &stateErr)
+			} else {
+				c.Assert(err, qt.IsNil)
+			}
+			c.Assert(resize.State, qt.Equals, tt.wantState)
+		})
+	}
+}
+
+func TestResizes_WaitForPostgresBouncerResize(t *testing.T) {
+	c := qt.New(t)
+
+	var cancels atomic.Int32
+	client := resizeServer(c, "/v1/organizations/my-org/databases/my-db/branches/main/bouncers/my-bouncer/resizes", []string{
+		`{"data":[{"id":"r2","state":"pending"},{"id":"r1","state":"resizing"}]}`,
+		`{"data":[{"id":"r2","state":"pending"},{"id":"r1","state":"completed"}]}`,
+	}, &cancels)
+
+	var seen []string
+	resize, err := client.PostgresBouncers.WaitForResize(context.Background(), &WaitForPostgresBouncerResizeRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Bouncer:      "my-bouncer",
+		ID:           "r1",
+		Progress: func(r *PostgresBouncerResizeRequest) {
+			seen = append(seen, r.ID)
+		},
+	}, fastPoll)
+	c.Assert(err, qt.IsNil)
+	c.Assert(resize.ID, qt.Equals, "r1")
+	c.Assert(resize.State, qt.Equals, ResizeCompleted)
+	c.Assert(seen, qt.DeepEquals, []string{"r1", "r1"})
+}
+
+func TestResizes_WaitForPostgresBouncerResizeNotFound(t *testing.T) {
+	c := qt.New(t)
+
+	var cancels atomic.Int32
+	client := resizeServer(c, "/v1/organizations/my-org/databases/my-db/branches/main/bouncers/my-bouncer/resizes", []string{
+		`{"data":[{"id":"r2","state":"pending"}]}`,
+	}, &cancels)
+
+	_, err := client.PostgresBouncers.WaitForResize(context.Background(), &WaitForPostgresBouncerResizeRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "main",
+		Bouncer:      "my-bouncer",
+		ID:           "r1",
+	}, fastPoll)
+	c.Assert(err, qt.ErrorMatches, "resize r1 of bouncer my-bouncer not found")
+	var apiErr *Error
+	c.Assert(err, qt.ErrorAs, &apiErr)
+	c.Assert(apiErr.Code, qt.Equals, ErrNotFound)
+}
+
+func TestResizes_WaitForPostgresBranchChangeCancelOnDone(t *testing.T) {
+	tests := []struct {
+		desc        string
+		cancel      bool
+		wantCancels int32
+	}{
+		{desc: "cancel", cancel: true, wantCancels: 1},
+		{desc: "leave running", cancel: false, wantCancels: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			var cancels atomic.Int32
+			client := resizeServer(c, "/v1/organizations/my-org/databases/my-db/branches/main/changes/change-1", []string{
+				`{"id":"change-1","state":"resizing"}`,
+			}, &cancels)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			change, err := client.PostgresBranches.WaitForChange(ctx, &WaitForPostgresBranchChangeRequest{
+				Organization: "my-org",
+				Database:     "my-db",
+				Branch:       "main",
+				ID:           "change-1",
+				Progress: func(*PostgresBranchClusterResizeRequest) {
+					cancel()
+				},
+				CancelOnDone: tt.cancel,
+			}, fastPoll)
+			c.Assert(err, qt.ErrorIs, context.Canceled)
+			c.Assert(change.State, qt.Equals, ResizeResizing)
+			c.Assert(cancels.Load(), qt.Equals, tt.wantCancels)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package planetscale
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+	requestIDHeader          = "X-Request-Id"
+)
+
+// RateLimit is the rate-limit state reported by the API in the response
+// headers. Fields are zero when the API did not send the matching header.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+
+	// Reset is when the current window ends.
+	Reset time.Time
+}
+
+// ResponseMetadata holds information about an API response that is not part
+// of its body.
+type ResponseMetadata struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// RequestID is the ID the API assigned to the request. Quote it when
+	// contacting PlanetScale support.
+	RequestID string
+
+	// RateLimit is the rate-limit state after the request.
+	RateLimit RateLimit
+
+	// Header contains all response headers.
+	Header http.Header
+}
+
+type responseMetadataKey struct{}
+
+// CaptureResponseMetadata returns a context that makes every service method
+// called with it store the metadata of its API response into meta. When a
+// call sends several requests, e.g. because of retries, meta describes the
+// last one. On failure, the same metadata is available on the returned
+// *Error. The returned context should not be shared by concurrent calls.
+func CaptureResponseMetadata(ctx context.Context, meta *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, meta)
+}
+
+func newResponseMetadata(res *http.Response) *ResponseMetadata {
+	return &ResponseMetadata{
+		StatusCode: res.StatusCode,
+		RequestID:  res.Header.Get(requestIDHeader),
+		RateLimit:  parseRateLimit(res.Header, time.Now()),
+		Header:     res.Header,
+	}
+}
+
+// recordResponseMetadata stores meta into the destination registered with
+// CaptureResponseMetadata, if any.
+func recordResponseMetadata(ctx context.Context, meta *ResponseMetadata) {
+	if dst, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok && dst != nil {
+		*dst = *meta
+	}
+}
+
+// parseRateLimit reads the rate-limit headers. The reset header is accepted
+// either as a Unix timestamp or as a number of seconds relative to now.
+func parseRateLimit(header http.Header, now time.Time) RateLimit {
+	var rl RateLimit
+	if n, err := strconv.Atoi(strings.TrimSpace(header.Get(rateLimitLimitHeader))); err == nil {
+		rl.Limit = n
+	}
+	if n, err := strconv.Atoi(strings.TrimSpace(header.Get(rateLimitRemainingHeader))); err == nil {
+		rl.Remaining = n
+	}
+	if n, err := strconv.ParseInt(strings.TrimSpace(header.Get(rateLimitResetHeader)), 10, 64); err == nil && n >= 0 {
+		// Relative values are small; anything after 2001-09-09 is an epoch.
+		if n < 1_000_000_000 {
+			rl.Reset = now.Add(time.Duration(n) * time.Second)
+		} else {
+			rl.Reset = time.Unix(n, 0)
+		}
+	}
+	return rl
+}
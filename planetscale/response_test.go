@@ -0,0 +1,89 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestCaptureResponseMetadata(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "599")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"id": "planetscale-go-test-db", "name": "planetscale-go-test-db"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var meta ResponseMetadata
+	ctx := CaptureResponseMetadata(context.Background(), &meta)
+	_, err = client.Databases.Get(ctx, &GetDatabaseRequest{
+		Organization: "my-org",
+		Database:     "planetscale-go-test-db",
+	})
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(meta.StatusCode, qt.Equals, http.StatusOK)
+	c.Assert(meta.RequestID, qt.Equals, "req-123")
+	c.Assert(meta.RateLimit, qt.DeepEquals, RateLimit{
+		Limit:     600,
+		Remaining: 599,
+		Reset:     time.Unix(1700000000, 0),
+	})
+}
+
+func TestResponseMetadata_AttachedToError(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-404")
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"code": "not_found", "message": "Not Found"}`))
+		c.Assert(err, qt.IsNil)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	_, err = client.Databases.Get(context.Background(), &GetDatabaseRequest{
+		Organization: "my-org",
+		Database:     "missing",
+	})
+
+	var apiErr *Error
+	c.Assert(errors.As(err, &apiErr), qt.IsTrue)
+	c.Assert(apiErr.Response, qt.Not(qt.IsNil))
+	c.Assert(apiErr.Response.StatusCode, qt.Equals, http.StatusNotFound)
+	c.Assert(apiErr.Response.RequestID, qt.Equals, "req-404")
+}
+
+func TestParseRateLimit(t *testing.T) {
+	c := qt.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "30")
+	c.Assert(parseRateLimit(header, now), qt.DeepEquals, RateLimit{
+		Limit:     100,
+		Remaining: 0,
+		Reset:     now.Add(30 * time.Second),
+	})
+
+	c.Assert(parseRateLimit(http.Header{}, now), qt.DeepEquals, RateLimit{})
+}
@@ -0,0 +1,177 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+
+	// idempotencyKeyHeader marks a POST or PATCH request as safe to retry.
+	idempotencyKeyHeader = "Idempotency-Key"
+)
+
+// RetryPolicy configures how the client retries requests that fail with a
+// transient error: a network error, an HTTP 429 or 5xx response, or an API
+// error with the ErrRetry code. Only idempotent methods are retried, unless
+// the request carries an Idempotency-Key header.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first
+	// one. Defaults to 4.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. It doubles on every
+	// following retry. Defaults to 500ms.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts. A Retry-After header
+	// sent by the API takes precedence over it. Defaults to 30s.
+	MaxBackoff time.Duration
+
+	// MaxElapsed bounds the total time spent on a single call, including
+	// all retries. It is applied as a deadline on the request context, and
+	// no retry is attempted if its backoff would end after that deadline.
+	// Zero means no limit besides the caller's context.
+	MaxElapsed time.Duration
+}
+
+// WithRetryPolicy configures the client to retry transient failures
+// according to the given policy. Zero fields use their documented default.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy.MaxAttempts < 0 || policy.MinBackoff < 0 || policy.MaxBackoff < 0 || policy.MaxElapsed < 0 {
+			return errors.New("retry policy values must not be negative")
+		}
+		if policy.MaxAttempts == 0 {
+			policy.MaxAttempts = defaultRetryMaxAttempts
+		}
+		if policy.MinBackoff == 0 {
+			policy.MinBackoff = defaultRetryMinBackoff
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = defaultRetryMaxBackoff
+		}
+		if policy.MaxBackoff < policy.MinBackoff {
+			return fmt.Errorf("retry max backoff %s is lower than min backoff %s", policy.MaxBackoff, policy.MinBackoff)
+		}
+
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// retryable reports whether req may be sent more than once.
+func (p *RetryPolicy) retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return req.Header.Get(idempotencyKeyHeader) != ""
+	}
+}
+
+// backoff returns the delay to wait before the attempt following the given
+// one. It uses exponential backoff with equal jitter, unless the API asked
+// for a longer delay with Retry-After.
+func (p *RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	wait := p.MaxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if d := p.MinBackoff << shift; d > 0 && d < wait {
+			wait = d
+		}
+	}
+	wait = wait/2 + rand.N(wait/2+1)
+
+	if retryAfter > wait {
+		wait = retryAfter
+	}
+	return wait
+}
+
+// shouldRetryResponse reports whether a completed response is a transient
+// failure.
+func shouldRetryResponse(statusCode int, err error) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code == ErrRetry
+}
+
+// doWithRetry sends req until it succeeds, fails permanently or the policy
+// is exhausted. The last response headers and error are returned.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request, v interface{}) (http.Header, error) {
+	p := c.retryPolicy
+
+	var deadline time.Time
+	if p.MaxElapsed > 0 {
+		deadline = time.Now().Add(p.MaxElapsed)
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("rewinding request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		var (
+			headers    http.Header
+			retryAfter time.Duration
+		)
+		res, err := c.send(ctx, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+		} else {
+			headers = res.Header
+			err = c.handleResponse(ctx, res, v)
+			res.Body.Close()
+			if !shouldRetryResponse(res.StatusCode, err) {
+				return headers, err
+			}
+			retryAfter = parseRetryAfter(headers.Get("Retry-After"))
+		}
+
+		if attempt >= p.MaxAttempts {
+			return headers, err
+		}
+
+		wait := p.backoff(attempt, retryAfter)
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			return headers, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return headers, err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package planetscale
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDo_RetryPolicy(t *testing.T) {
+	tests := []struct {
+		desc         string
+		method       string
+		header       map[string]string
+		statuses     []int
+		policy       RetryPolicy
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			desc:         "retries a GET on 502 until it succeeds",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 3,
+		},
+		{
+			desc:         "gives up after max attempts",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			policy:       RetryPolicy{MaxAttempts: 2},
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+		{
+			desc:         "does not retry client errors",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			desc:         "does not retry a POST without idempotency key",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			desc:         "retries a POST with an idempotency key",
+			method:       http.MethodPost,
+			header:       map[string]string{"Idempotency-Key": "key"},
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := qt.New(t)
+
+			attempts := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				c.Assert(err, qt.IsNil)
+				if r.Method == http.MethodPost {
+					c.Assert(string(body), qt.Equals, "{\"name\":\"foo\"}\n")
+				}
+
+				status := tt.statuses[attempts]
+				attempts++
+				w.WriteHeader(status)
+				_, err = w.Write([]byte(`{}`))
+				c.Assert(err, qt.IsNil)
+			}))
+			t.Cleanup(ts.Close)
+
+			policy := tt.policy
+			policy.MinBackoff = time.Millisecond
+			policy.MaxBackoff = 5 * time.Millisecond
+			client, err := NewClient(WithBaseURL(ts.URL), WithRetryPolicy(policy))
+			c.Assert(err, qt.IsNil)
+
+			var body interface{}
+			if tt.method == http.MethodPost {
+				body = map[string]string{"name": "foo"}
+			}
+			req, err := client.newRequest(tt.method, "/api-endpoint", body)
+			c.Assert(err, qt.IsNil)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+
+			err = client.do(context.Background(), req, nil)
+			if tt.wantErr {
+				c.Assert(err, qt.Not(qt.IsNil))
+			} else {
+				c.Assert(err, qt.IsNil)
+			}
+			c.Assert(attempts, qt.Equals, tt.wantAttempts)
+		})
+	}
+}
+
+func TestDo_RetryPolicyHonorsRetryAfter(t *testing.T) {
+	c := qt.New(t)
+
+	var times []time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		if len(times) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithRetryPolicy(RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+	}))
+	c.Assert(err, qt.IsNil)
+
+	err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/api-endpoint"), nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(times, qt.HasLen, 2)
+	c.Assert(times[1].Sub(times[0]) >= time.Second, qt.IsTrue)
+}
+
+func TestDo_RetryPolicyMaxElapsed(t *testing.T) {
+	c := qt.New(t)
+
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(WithBaseURL(ts.URL), WithRetryPolicy(RetryPolicy{
+		MaxElapsed: time.Second,
+	}))
+	c.Assert(err, qt.IsNil)
+
+	err = client.do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/api-endpoint"), nil)
+	c.Assert(err, qt.Not(qt.IsNil))
+	c.Assert(attempts, qt.Equals, 1)
+}
+
+func TestWithRetryPolicy_RejectsInvalidValues(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := NewClient(WithRetryPolicy(RetryPolicy{MaxAttempts: -1}))
+	c.Assert(err, qt.ErrorMatches, "retry policy values must not be negative")
+
+	_, err = NewClient(WithRetryPolicy(RetryPolicy{MinBackoff: time.Minute, MaxBackoff: time.Second}))
+	c.Assert(err, qt.ErrorMatches, "retry max backoff 1s is lower than min backoff 1m0s")
+}
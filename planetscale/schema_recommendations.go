@@ -2,6 +2,7 @@ package planetscale
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -13,11 +14,13 @@ var _ SchemaRecommendationService = &schemaRecommendationService{}
 // Schema recommendation API.
 type SchemaRecommendationService interface {
 	List(context.Context, *ListSchemaRecommendationsRequest, ...ListOption) ([]*SchemaRecommendation, error)
+	All(context.Context, *ListSchemaRecommendationsRequest, ...ListOption) iter.Seq2[*SchemaRecommendation, error]
 	Get(context.Context, *GetSchemaRecommendationRequest) (*SchemaRecommendation, error)
 	Dismiss(context.Context, *DismissSchemaRecommendationRequest) (*SchemaRecommendation, error)
 }
 
 type schemaRecommendationsResponse struct {
+	Pagination
 	SchemaRecommendations []*SchemaRecommendation `json:"data"`
 }
 
@@ -75,7 +78,7 @@ type schemaRecommendationService struct {
 func (s *schemaRecommendationService) List(ctx context.Context, request *ListSchemaRecommendationsRequest, opts ...ListOption) ([]*SchemaRecommendation, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := s.client.newRequest(http.MethodGet, schemaRecommendationsAPIPath(request.Organization, request.Database), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := s.client.newRequest(http.MethodGet, schemaRecommendationsAPIPath(request.Organization, request.Database), nil, WithQueryParams(*listOpts.URLValues), withOperation("SchemaRecommendations", "List"))
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +87,21 @@ func (s *schemaRecommendationService) List(ctx context.Context, request *ListSch
 	if err := s.client.do(ctx, req, &resp); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(resp.Pagination)
 
 	return resp.SchemaRecommendations, nil
 }
 
+// All returns an iterator over all schema recommendations of a database,
+// fetching pages as needed.
+func (s *schemaRecommendationService) All(ctx context.Context, request *ListSchemaRecommendationsRequest, opts ...ListOption) iter.Seq2[*SchemaRecommendation, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*SchemaRecommendation, error) {
+		return s.List(ctx, request, opts...)
+	})
+}
+
 func (s *schemaRecommendationService) Get(ctx context.Context, request *GetSchemaRecommendationRequest) (*SchemaRecommendation, error) {
-	req, err := s.client.newRequest(http.MethodGet, schemaRecommendationAPIPath(request.Organization, request.Database, request.ID), nil)
+	req, err := s.client.newRequest(http.MethodGet, schemaRecommendationAPIPath(request.Organization, request.Database, request.ID), nil, withOperation("SchemaRecommendations", "Get"))
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +115,7 @@ func (s *schemaRecommendationService) Get(ctx context.Context, request *GetSchem
 }
 
 func (s *schemaRecommendationService) Dismiss(ctx context.Context, request *DismissSchemaRecommendationRequest) (*SchemaRecommendation, error) {
-	req, err := s.client.newRequest(http.MethodPost, dismissSchemaRecommendationAPIPath(request.Organization, request.Database, request.ID), request)
+	req, err := s.client.newRequest(http.MethodPost, dismissSchemaRecommendationAPIPath(request.Organization, request.Database, request.ID), request, withOperation("SchemaRecommendations", "Dismiss"))
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package planetscale
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -13,7 +14,8 @@ var _ ServiceTokenService = &serviceTokenService{}
 // Service Token API.
 type ServiceTokenService interface {
 	Create(context.Context, *CreateServiceTokenRequest) (*ServiceToken, error)
-	List(context.Context, *ListServiceTokensRequest) ([]*ServiceToken, error)
+	List(context.Context, *ListServiceTokensRequest, ...ListOption) ([]*ServiceToken, error)
+	All(context.Context, *ListServiceTokensRequest, ...ListOption) iter.Seq2[*ServiceToken, error]
 	ListGrants(context.Context, *ListServiceTokenGrantsRequest) ([]*ServiceTokenGrant, error)
 	Delete(context.Context, *DeleteServiceTokenRequest) error
 	GetAccess(context.Context, *GetServiceTokenAccessRequest) ([]*ServiceTokenAccess, error)
@@ -26,7 +28,7 @@ type serviceTokenService struct {
 }
 
 func (s *serviceTokenService) Create(ctx context.Context, createReq *CreateServiceTokenRequest) (*ServiceToken, error) {
-	req, err := s.client.newRequest(http.MethodPost, serviceTokensAPIPath(createReq.Organization), createReq)
+	req, err := s.client.newRequest(http.MethodPost, serviceTokensAPIPath(createReq.Organization), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("ServiceTokens", "Create"))
 	if err != nil {
 		return nil, err
 	}
@@ -39,8 +41,15 @@ func (s *serviceTokenService) Create(ctx context.Context, createReq *CreateServi
 	return st, nil
 }
 
-func (s *serviceTokenService) List(ctx context.Context, listReq *ListServiceTokensRequest) ([]*ServiceToken, error) {
-	req, err := s.client.newRequest(http.MethodGet, serviceTokensAPIPath(listReq.Organization), nil)
+func (s *serviceTokenService) List(ctx context.Context, listReq *ListServiceTokensRequest, opts ...ListOption) ([]*ServiceToken, error) {
+	listOpts := defaultListOptions()
+	for _, opt := range opts {
+		if err := opt(listOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, serviceTokensAPIPath(listReq.Organization), nil, WithQueryParams(*listOpts.URLValues), withOperation("ServiceTokens", "List"))
 	if err != nil {
 		return nil, err
 	}
@@ -49,12 +58,21 @@ func (s *serviceTokenService) List(ctx context.Context, listReq *ListServiceToke
 	if err := s.client.do(ctx, req, &tokenListResponse); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(tokenListResponse.Pagination)
 
 	return tokenListResponse.ServiceTokens, nil
 }
 
+// All returns an iterator over all service tokens of an organization,
+// fetching pages as needed.
+func (s *serviceTokenService) All(ctx context.Context, listReq *ListServiceTokensRequest, opts ...ListOption) iter.Seq2[*ServiceToken, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*ServiceToken, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 func (s *serviceTokenService) Delete(ctx context.Context, delReq *DeleteServiceTokenRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, serviceTokenAPIPath(delReq.Organization, delReq.ID), nil)
+	req, err := s.client.newRequest(http.MethodDelete, serviceTokenAPIPath(delReq.Organization, delReq.ID), nil, withOperation("ServiceTokens", "Delete"))
 	if err != nil {
 		return err
 	}
@@ -64,7 +82,7 @@ func (s *serviceTokenService) Delete(ctx context.Context, delReq *DeleteServiceT
 }
 
 func (s *serviceTokenService) GetAccess(ctx context.Context, accessReq *GetServiceTokenAccessRequest) ([]*ServiceTokenAccess, error) {
-	req, err := s.client.newRequest(http.MethodGet, serviceTokenAccessAPIPath(accessReq.Organization, accessReq.ID), nil)
+	req, err := s.client.newRequest(http.MethodGet, serviceTokenAccessAPIPath(accessReq.Organization, accessReq.ID), nil, withOperation("ServiceTokens", "GetAccess"))
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +95,7 @@ func (s *serviceTokenService) GetAccess(ctx context.Context, accessReq *GetServi
 }
 
 func (s *serviceTokenService) ListGrants(ctx context.Context, listReq *ListServiceTokenGrantsRequest) ([]*ServiceTokenGrant, error) {
-	req, err := s.client.newRequest(http.MethodGet, serviceTokenGrantsAPIPath(listReq.Organization, listReq.ID), nil)
+	req, err := s.client.newRequest(http.MethodGet, serviceTokenGrantsAPIPath(listReq.Organization, listReq.ID), nil, withOperation("ServiceTokens", "ListGrants"))
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +108,7 @@ func (s *serviceTokenService) ListGrants(ctx context.Context, listReq *ListServi
 }
 
 func (s *serviceTokenService) AddAccess(ctx context.Context, addReq *AddServiceTokenAccessRequest) ([]*ServiceTokenAccess, error) {
-	req, err := s.client.newRequest(http.MethodPost, serviceTokenAccessAPIPath(addReq.Organization, addReq.ID), addReq)
+	req, err := s.client.newRequest(http.MethodPost, serviceTokenAccessAPIPath(addReq.Organization, addReq.ID), addReq, withOperation("ServiceTokens", "AddAccess"))
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +121,7 @@ func (s *serviceTokenService) AddAccess(ctx context.Context, addReq *AddServiceT
 }
 
 func (s *serviceTokenService) DeleteAccess(ctx context.Context, delReq *DeleteServiceTokenAccessRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, serviceTokenAccessAPIPath(delReq.Organization, delReq.ID), delReq)
+	req, err := s.client.newRequest(http.MethodDelete, serviceTokenAccessAPIPath(delReq.Organization, delReq.ID), delReq, withOperation("ServiceTokens", "DeleteAccess"))
 	if err != nil {
 		return err
 	}
@@ -116,6 +134,10 @@ type CreateServiceTokenRequest struct {
 	Organization string  `json:"-"`
 	Name         *string `json:"name,omitempty"`
 	TTL          *int    `json:"ttl,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 type ListServiceTokenGrantsRequest struct {
@@ -175,6 +197,7 @@ type ServiceTokenGrantAccess struct {
 }
 
 type serviceTokensResponse struct {
+	Pagination
 	ServiceTokens []*ServiceToken `json:"data"`
 }
 
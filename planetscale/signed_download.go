@@ -49,6 +49,12 @@ func (c *Client) downloadSignedURL(ctx context.Context, req *http.Request) (io.R
 		return http.ErrUseLastResponse
 	}
 
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	res, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, newSignedDownloadTransportError(req, err)
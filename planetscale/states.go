@@ -0,0 +1,29 @@
+package planetscale
+
+// stateClass classifies the known values of a state type. The state types
+// of this package are plain strings so values the API adds later decode
+// without error; such values classify as stateUnknown and all their helpers
+// return false.
+type stateClass uint8
+
+const (
+	stateUnknown stateClass = iota
+
+	// stateWaiting covers states in which nothing is happening yet, or
+	// the resource waits for an action by the user.
+	stateWaiting
+
+	// stateInProgress covers states in which work is under way.
+	stateInProgress
+
+	// stateFinished and stateFailed cover states that the resource won't
+	// leave on its own, reached without and with an error. Cancellations
+	// are asked for, so every state type classifies them as finished
+	// rather than failed.
+	stateFinished
+	stateFailed
+)
+
+func (c stateClass) terminal() bool {
+	return c == stateFinished || c == stateFailed
+}
@@ -0,0 +1,59 @@
+package planetscale
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+// stateHelpers is implemented by the state types of this package.
+type stateHelpers interface {
+	IsKnown() bool
+	IsTerminal() bool
+	IsFailed() bool
+	IsInProgress() bool
+}
+
+func TestStateHelpers(t *testing.T) {
+	tests := []struct {
+		state                               stateHelpers
+		known, terminal, failed, inProgress bool
+	}{
+		{DeployRequestOpen, true, false, false, false},
+		{DeployRequestClosed, true, true, false, false},
+		{DeploymentQueued, true, false, false, false},
+		{DeploymentPendingCutover, true, false, false, false},
+		{DeploymentCompletePendingRevert, true, false, false, false},
+		{DeploymentComplete, true, true, false, false},
+		{DeploymentCompleteCancel, true, true, false, false},
+		{DeploymentCompleteError, true, true, true, false},
+		{DeploymentCancelled, true, true, false, false},
+		{DeploymentState("complete_new_state"), false, false, false, false},
+		{BackupRunning, true, false, false, true},
+		{BackupSuccess, true, true, false, false},
+		{BackupFailed, true, true, true, false},
+		{BackupCanceled, true, true, false, false},
+		{WorkflowCopying, true, false, false, true},
+		{WorkflowSwitchedReplicas, true, false, false, false},
+		{WorkflowError, true, true, true, false},
+		{WorkflowCancelled, true, true, false, false},
+		{PostgresBranchAwakening, true, false, false, true},
+		{PostgresBranchReady, true, true, false, false},
+		{VtctldOperationRunning, true, false, false, true},
+		{VtctldOperationFailed, true, true, true, false},
+		{QueryPatternsReportPending, true, false, false, true},
+		{QueryPatternsReportCompleted, true, true, false, false},
+		{ResizeQueued, true, false, false, false},
+		{ResizeResizing, true, false, false, true},
+		{ResizeCanceled, true, true, false, false},
+		{ResizeState(""), false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		c := qt.New(t)
+		c.Assert(tt.state.IsKnown(), qt.Equals, tt.known, qt.Commentf("%T %q", tt.state, tt.state))
+		c.Assert(tt.state.IsTerminal(), qt.Equals, tt.terminal, qt.Commentf("%T %q", tt.state, tt.state))
+		c.Assert(tt.state.IsFailed(), qt.Equals, tt.failed, qt.Commentf("%T %q", tt.state, tt.state))
+		c.Assert(tt.state.IsInProgress(), qt.Equals, tt.inProgress, qt.Commentf("%T %q", tt.state, tt.state))
+	}
+}
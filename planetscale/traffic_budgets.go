@@ -2,6 +2,7 @@ package planetscale
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -11,7 +12,8 @@ var _ TrafficBudgetsService = &trafficBudgetsService{}
 
 // TrafficBudgetsService communicates with the PlanetScale traffic budgets API.
 type TrafficBudgetsService interface {
-	List(context.Context, *ListTrafficBudgetsRequest) ([]*TrafficBudget, error)
+	List(context.Context, *ListTrafficBudgetsRequest, ...ListOption) ([]*TrafficBudget, error)
+	All(context.Context, *ListTrafficBudgetsRequest, ...ListOption) iter.Seq2[*TrafficBudget, error]
 	Get(context.Context, *GetTrafficBudgetRequest) (*TrafficBudget, error)
 	Create(context.Context, *CreateTrafficBudgetRequest) (*TrafficBudget, error)
 	Update(context.Context, *UpdateTrafficBudgetRequest) (*TrafficBudget, error)
@@ -63,6 +65,7 @@ type TrafficBudget struct {
 }
 
 type trafficBudgetsResponse struct {
+	Pagination
 	Data []*TrafficBudget `json:"data"`
 }
 
@@ -105,6 +108,10 @@ type CreateTrafficBudgetRequest struct {
 	WarningThreshold *int `json:"warning_threshold,omitempty"`
 
 	Rules *[]CreateTrafficBudgetRuleRequest `json:"rules,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // UpdateTrafficBudgetRequest is the request for updating a traffic budget.
@@ -143,8 +150,15 @@ func NewTrafficBudgetsService(client *Client) *trafficBudgetsService {
 	return &trafficBudgetsService{client: client}
 }
 
-func (s *trafficBudgetsService) List(ctx context.Context, listReq *ListTrafficBudgetsRequest) ([]*TrafficBudget, error) {
-	req, err := s.client.newRequest(http.MethodGet, trafficBudgetsAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil)
+func (s *trafficBudgetsService) List(ctx context.Context, listReq *ListTrafficBudgetsRequest, opts ...ListOption) ([]*TrafficBudget, error) {
+	listOpts := defaultListOptions()
+	for _, opt := range opts {
+		if err := opt(listOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, trafficBudgetsAPIPath(listReq.Organization, listReq.Database, listReq.Branch), nil, WithQueryParams(*listOpts.URLValues), withOperation("TrafficBudgets", "List"))
 	if err != nil {
 		return nil, err
 	}
@@ -153,12 +167,21 @@ func (s *trafficBudgetsService) List(ctx context.Context, listReq *ListTrafficBu
 	if err := s.client.do(ctx, req, resp); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(resp.Pagination)
 
 	return resp.Data, nil
 }
 
+// All returns an iterator over all traffic budgets of a branch, fetching pages
+// as needed.
+func (s *trafficBudgetsService) All(ctx context.Context, listReq *ListTrafficBudgetsRequest, opts ...ListOption) iter.Seq2[*TrafficBudget, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*TrafficBudget, error) {
+		return s.List(ctx, listReq, opts...)
+	})
+}
+
 func (s *trafficBudgetsService) Get(ctx context.Context, getReq *GetTrafficBudgetRequest) (*TrafficBudget, error) {
-	req, err := s.client.newRequest(http.MethodGet, trafficBudgetAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.BudgetID), nil)
+	req, err := s.client.newRequest(http.MethodGet, trafficBudgetAPIPath(getReq.Organization, getReq.Database, getReq.Branch, getReq.BudgetID), nil, withOperation("TrafficBudgets", "Get"))
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +195,7 @@ func (s *trafficBudgetsService) Get(ctx context.Context, getReq *GetTrafficBudge
 }
 
 func (s *trafficBudgetsService) Create(ctx context.Context, createReq *CreateTrafficBudgetRequest) (*TrafficBudget, error) {
-	req, err := s.client.newRequest(http.MethodPost, trafficBudgetsAPIPath(createReq.Organization, createReq.Database, createReq.Branch), createReq)
+	req, err := s.client.newRequest(http.MethodPost, trafficBudgetsAPIPath(createReq.Organization, createReq.Database, createReq.Branch), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("TrafficBudgets", "Create"))
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +209,7 @@ func (s *trafficBudgetsService) Create(ctx context.Context, createReq *CreateTra
 }
 
 func (s *trafficBudgetsService) Update(ctx context.Context, updateReq *UpdateTrafficBudgetRequest) (*TrafficBudget, error) {
-	req, err := s.client.newRequest(http.MethodPatch, trafficBudgetAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.BudgetID), updateReq)
+	req, err := s.client.newRequest(http.MethodPatch, trafficBudgetAPIPath(updateReq.Organization, updateReq.Database, updateReq.Branch, updateReq.BudgetID), updateReq, withOperation("TrafficBudgets", "Update"))
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +223,7 @@ func (s *trafficBudgetsService) Update(ctx context.Context, updateReq *UpdateTra
 }
 
 func (s *trafficBudgetsService) Delete(ctx context.Context, deleteReq *DeleteTrafficBudgetRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, trafficBudgetAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.BudgetID), nil)
+	req, err := s.client.newRequest(http.MethodDelete, trafficBudgetAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.BudgetID), nil, withOperation("TrafficBudgets", "Delete"))
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,10 @@ type CreateTrafficRuleRequest struct {
 	Fingerprint  *string           `json:"fingerprint,omitempty"`
 	Keyspace     *string           `json:"keyspace,omitempty"`
 	Tags         *[]TrafficRuleTag `json:"tags,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // DeleteTrafficRuleRequest is the request for deleting a traffic rule.
@@ -45,7 +49,7 @@ func NewTrafficRulesService(client *Client) *trafficRulesService {
 }
 
 func (s *trafficRulesService) Create(ctx context.Context, createReq *CreateTrafficRuleRequest) (*TrafficRule, error) {
-	req, err := s.client.newRequest(http.MethodPost, trafficBudgetRulesAPIPath(createReq.Organization, createReq.Database, createReq.Branch, createReq.BudgetID), createReq)
+	req, err := s.client.newRequest(http.MethodPost, trafficBudgetRulesAPIPath(createReq.Organization, createReq.Database, createReq.Branch, createReq.BudgetID), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("TrafficRules", "Create"))
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +63,7 @@ func (s *trafficRulesService) Create(ctx context.Context, createReq *CreateTraff
 }
 
 func (s *trafficRulesService) Delete(ctx context.Context, deleteReq *DeleteTrafficRuleRequest) error {
-	req, err := s.client.newRequest(http.MethodDelete, trafficBudgetRuleAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.BudgetID, deleteReq.RuleID), nil)
+	req, err := s.client.newRequest(http.MethodDelete, trafficBudgetRuleAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.Branch, deleteReq.BudgetID, deleteReq.RuleID), nil, withOperation("TrafficRules", "Delete"))
 	if err != nil {
 		return err
 	}
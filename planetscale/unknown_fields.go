@@ -0,0 +1,199 @@
+package planetscale
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// UnknownFieldsError is returned by a client created with
+// WithStrictDecoding when a response has fields that the type it is decoded
+// into doesn't declare. Client.Do and Client.Raw still decode the response
+// into their v argument; service methods return only the error.
+type UnknownFieldsError struct {
+	// Fields are the paths of the unknown fields, such as
+	// "data[0].new_field", in sorted order.
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return "response has unknown fields: " + strings.Join(e.Fields, ", ")
+}
+
+// WithStrictDecoding reports fields of API responses that the client's types
+// don't declare with an *UnknownFieldsError, so tests can detect drift
+// between the API and this package. Fields kept in the Extra map of a type
+// are reported too. Service methods fail with the error and return no value,
+// so use Client.Do to inspect a response that has unknown fields.
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) error {
+		c.strictDecoding = true
+		return nil
+	}
+}
+
+// checkUnknownFields returns an *UnknownFieldsError if data has fields that
+// v's type doesn't declare.
+func checkUnknownFields(data []byte, v interface{}) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	var fields []string
+	collectUnknownFields(value, reflect.TypeOf(v), "", &fields)
+	if len(fields) == 0 {
+		return nil
+	}
+	sort.Strings(fields)
+	return &UnknownFieldsError{Fields: fields}
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	extraType           = reflect.TypeOf(map[string]json.RawMessage(nil))
+)
+
+// collectUnknownFields appends the paths of the fields of value that t
+// doesn't declare to fields. Types that decode themselves are not inspected,
+// except for the ones that keep unknown fields in Extra.
+func collectUnknownFields(value interface{}, t reflect.Type, path string, fields *[]string) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return
+	}
+	if !hasExtra(t) && (reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType)) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		known := jsonFields(t)
+		for key, val := range obj {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			ft, ok := known[strings.ToLower(key)]
+			if !ok && key != typeField {
+				*fields = append(*fields, fieldPath)
+				continue
+			}
+			collectUnknownFields(val, ft, fieldPath, fields)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := value.([]interface{})
+		if !ok {
+			return
+		}
+		for i, val := range arr {
+			collectUnknownFields(val, t.Elem(), path+"["+strconv.Itoa(i)+"]", fields)
+		}
+	case reflect.Map:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, val := range obj {
+			collectUnknownFields(val, t.Elem(), path+"["+strconv.Quote(key)+"]", fields)
+		}
+	}
+}
+
+// typeField is the key the API uses to name the type of every object. It is
+// never reported as unknown.
+const typeField = "type"
+
+var jsonFieldsCache sync.Map // map[reflect.Type]map[string]reflect.Type
+
+// jsonFields returns the types of the fields encoding/json decodes into t,
+// keyed by their lower-cased JSON names, since encoding/json matches names
+// case-insensitively.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	if fields, ok := jsonFieldsCache.Load(t); ok {
+		return fields.(map[string]reflect.Type)
+	}
+
+	fields := make(map[string]reflect.Type)
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f.Type
+	}
+	// Fields of embedded structs are promoted unless the outer struct
+	// declares the same name.
+	for _, et := range embedded {
+		for name, ft := range jsonFields(et) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+
+	jsonFieldsCache.Store(t, fields)
+	return fields
+}
+
+// hasExtra reports whether t is a struct that keeps unknown fields in Extra.
+func hasExtra(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	f, ok := t.FieldByName("Extra")
+	return ok && f.Type == extraType && f.Tag.Get("json") == "-"
+}
+
+// unmarshalWithExtra decodes data into v, a pointer to a struct without
+// methods, and returns the fields v's type doesn't declare. It is used by
+// the UnmarshalJSON methods of types with an Extra field.
+func unmarshalWithExtra(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	known := jsonFields(reflect.TypeOf(v).Elem())
+	var extra map[string]json.RawMessage
+	for key, val := range raw {
+		if _, ok := known[strings.ToLower(key)]; ok || key == typeField {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]json.RawMessage)
+		}
+		extra[key] = val
+	}
+	return extra, nil
+}
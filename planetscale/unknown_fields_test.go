@@ -0,0 +1,75 @@
+package planetscale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestExtraFields(t *testing.T) {
+	c := qt.New(t)
+
+	var dr DeployRequest
+	err := json.Unmarshal([]byte(`{
+		"id": "dr-1",
+		"type": "DeployRequest",
+		"number": 7,
+		"Notes": "case-insensitive",
+		"deployment": {"id": "d-1", "state": "ready"},
+		"lint_errors": [],
+		"html_url": "https://app.planetscale.com"
+	}`), &dr)
+	c.Assert(err, qt.IsNil)
+	c.Assert(dr.ID, qt.Equals, "dr-1")
+	c.Assert(dr.Number, qt.Equals, uint64(7))
+	c.Assert(dr.Notes, qt.Equals, "case-insensitive")
+	c.Assert(dr.Deployment.State, qt.Equals, DeploymentReady)
+	c.Assert(dr.Extra, qt.DeepEquals, map[string]json.RawMessage{"lint_errors": json.RawMessage(`[]`)})
+
+	var branch DatabaseBranch
+	err = json.Unmarshal([]byte(`{"id": "branch-1", "name": "main"}`), &branch)
+	c.Assert(err, qt.IsNil)
+	c.Assert(branch.Extra, qt.IsNil)
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	c := qt.New(t)
+
+	response := `{"data": [{"id": "branch-1", "name": "main", "type": "Branch", "actor": {"id": "a-1", "avatar": "x"}, "cluster_rate_name": "PS_10"}]}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(ts.Close)
+
+	listReq := &ListDatabaseBranchesRequest{Organization: "my-org", Database: "my-db"}
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+	_, err = client.DatabaseBranches.List(context.Background(), listReq)
+	c.Assert(err, qt.IsNil)
+
+	client, err = NewClient(WithBaseURL(ts.URL), WithStrictDecoding())
+	c.Assert(err, qt.IsNil)
+	_, err = client.DatabaseBranches.List(context.Background(), listReq)
+	var unknownErr *UnknownFieldsError
+	c.Assert(errors.As(err, &unknownErr), qt.IsTrue)
+	c.Assert(unknownErr.Fields, qt.DeepEquals, []string{"data[0].actor.avatar", "data[0].cluster_rate_name"})
+	c.Assert(err, qt.ErrorMatches, `response has unknown fields: data\[0\].actor.avatar, data\[0\].cluster_rate_name`)
+
+	// Client.Do decodes the response regardless.
+	var raw databaseBranchesResponse
+	err = client.Do(context.Background(), mustNewRequest(t, client, http.MethodGet, "/v1/organizations/my-org/databases/my-db/branches"), &raw)
+	c.Assert(errors.As(err, &unknownErr), qt.IsTrue)
+	c.Assert(raw.Branches[0].Name, qt.Equals, "main")
+
+	response = `{"data": [{"id": "branch-1", "name": "main", "type": "Branch", "actor": {"id": "a-1"}}]}`
+	branches, err := client.DatabaseBranches.List(context.Background(), listReq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(branches[0].Name, qt.Equals, "main")
+}
@@ -28,6 +28,7 @@ type VtctldService interface {
 	CheckThrottler(context.Context, *VtctldCheckThrottlerRequest) (json.RawMessage, error)
 	UpdateThrottlerConfig(context.Context, *VtctldUpdateThrottlerConfigRequest) (json.RawMessage, error)
 	GetOperation(context.Context, *GetVtctldOperationRequest) (*VtctldOperation, error)
+	WaitOperation(context.Context, *WaitVtctldOperationRequest, ...WaitOption) (*VtctldOperation, error)
 }
 
 type VtctldListWorkflowsRequest struct {
@@ -250,7 +251,7 @@ func (s *vtctldService) ListWorkflows(ctx context.Context, req *VtctldListWorkfl
 	if req.IncludeLogs != nil {
 		v.Set("include_logs", strconv.FormatBool(*req.IncludeLogs))
 	}
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Vtctld", "ListWorkflows"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -267,7 +268,7 @@ func (s *vtctldService) ListKeyspaces(ctx context.Context, req *VtctldListKeyspa
 	if req.Name != "" {
 		v.Set("name", req.Name)
 	}
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Vtctld", "ListKeyspaces"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -280,7 +281,7 @@ func (s *vtctldService) ListKeyspaces(ctx context.Context, req *VtctldListKeyspa
 
 func (s *vtctldService) GetRoutingRules(ctx context.Context, req *VtctldGetRoutingRulesRequest) (json.RawMessage, error) {
 	p := vtctldRoutingRulesAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil)
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, withOperation("Vtctld", "GetRoutingRules"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -293,7 +294,7 @@ func (s *vtctldService) GetRoutingRules(ctx context.Context, req *VtctldGetRouti
 
 func (s *vtctldService) GetKeyspaceRoutingRules(ctx context.Context, req *VtctldGetKeyspaceRoutingRulesRequest) (json.RawMessage, error) {
 	p := vtctldKeyspaceRoutingRulesAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil)
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, withOperation("Vtctld", "GetKeyspaceRoutingRules"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -306,7 +307,7 @@ func (s *vtctldService) GetKeyspaceRoutingRules(ctx context.Context, req *Vtctld
 
 func (s *vtctldService) ApplyKeyspaceRoutingRules(ctx context.Context, req *VtctldApplyKeyspaceRoutingRulesRequest) (json.RawMessage, error) {
 	p := vtctldKeyspaceRoutingRulesAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodPut, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPut, p, req, withOperation("Vtctld", "ApplyKeyspaceRoutingRules"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -322,7 +323,7 @@ func (s *vtctldService) GetShard(ctx context.Context, req *VtctldGetShardRequest
 	v := url.Values{}
 	v.Set("keyspace", req.Keyspace)
 	v.Set("shard", req.Shard)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Vtctld", "GetShard"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -339,7 +340,7 @@ func vtctldWorkflowAPIPath(org, db, branch, workflow string) string {
 
 func (s *vtctldService) StartWorkflow(ctx context.Context, req *VtctldStartWorkflowRequest) (json.RawMessage, error) {
 	p := path.Join(vtctldWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "start")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Vtctld", "StartWorkflow"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -352,7 +353,7 @@ func (s *vtctldService) StartWorkflow(ctx context.Context, req *VtctldStartWorkf
 
 func (s *vtctldService) StopWorkflow(ctx context.Context, req *VtctldStopWorkflowRequest) (json.RawMessage, error) {
 	p := path.Join(vtctldWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "stop")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Vtctld", "StopWorkflow"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -372,7 +373,7 @@ func (s *vtctldService) GetThrottlerStatus(ctx context.Context, req *VtctldGetTh
 	p := path.Join(vtctldThrottlerAPIPath(req.Organization, req.Database, req.Branch), "status")
 	v := url.Values{}
 	v.Set("tablet_alias", req.TabletAlias)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Vtctld", "GetThrottlerStatus"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -386,7 +387,7 @@ func (s *vtctldService) GetThrottlerStatus(ctx context.Context, req *VtctldGetTh
 // SetShardTabletControl updates tablet controls on a shard via vtctld.
 func (s *vtctldService) SetShardTabletControl(ctx context.Context, req *VtctldSetShardTabletControlRequest) (json.RawMessage, error) {
 	p := vtctldShardTabletControlAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodPut, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPut, p, req, withOperation("Vtctld", "SetShardTabletControl"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -400,7 +401,7 @@ func (s *vtctldService) SetShardTabletControl(ctx context.Context, req *VtctldSe
 // RefreshStateByShard reloads tablet records for all tablets in a shard via vtctld.
 func (s *vtctldService) RefreshStateByShard(ctx context.Context, req *VtctldRefreshStateByShardRequest) (json.RawMessage, error) {
 	p := vtctldShardRefreshStateAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Vtctld", "RefreshStateByShard"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -414,7 +415,7 @@ func (s *vtctldService) RefreshStateByShard(ctx context.Context, req *VtctldRefr
 // CheckThrottler issues a throttler check against a single tablet.
 func (s *vtctldService) CheckThrottler(ctx context.Context, req *VtctldCheckThrottlerRequest) (json.RawMessage, error) {
 	p := path.Join(vtctldThrottlerAPIPath(req.Organization, req.Database, req.Branch), "check")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Vtctld", "CheckThrottler"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -428,7 +429,7 @@ func (s *vtctldService) CheckThrottler(ctx context.Context, req *VtctldCheckThro
 // UpdateThrottlerConfig updates the tablet throttler configuration for a keyspace.
 func (s *vtctldService) UpdateThrottlerConfig(ctx context.Context, req *VtctldUpdateThrottlerConfigRequest) (json.RawMessage, error) {
 	p := path.Join(vtctldThrottlerAPIPath(req.Organization, req.Database, req.Branch), "config")
-	httpReq, err := s.client.newRequest(http.MethodPut, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPut, p, req, withOperation("Vtctld", "UpdateThrottlerConfig"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -100,7 +100,7 @@ func lookupVindexAPIPath(org, db, branch, name string) string {
 
 func (s *lookupVindexService) Create(ctx context.Context, req *LookupVindexCreateRequest) (json.RawMessage, error) {
 	p := lookupVindexVindexesAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("LookupVindex", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -115,7 +115,7 @@ func (s *lookupVindexService) Show(ctx context.Context, req *LookupVindexShowReq
 	p := lookupVindexAPIPath(req.Organization, req.Database, req.Branch, req.Name)
 	v := url.Values{}
 	v.Set("table_keyspace", req.TableKeyspace)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("LookupVindex", "Show"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -128,7 +128,7 @@ func (s *lookupVindexService) Show(ctx context.Context, req *LookupVindexShowReq
 
 func (s *lookupVindexService) Externalize(ctx context.Context, req *LookupVindexExternalizeRequest) (json.RawMessage, error) {
 	p := path.Join(lookupVindexAPIPath(req.Organization, req.Database, req.Branch, req.Name), "externalize")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("LookupVindex", "Externalize"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -141,7 +141,7 @@ func (s *lookupVindexService) Externalize(ctx context.Context, req *LookupVindex
 
 func (s *lookupVindexService) Internalize(ctx context.Context, req *LookupVindexInternalizeRequest) (json.RawMessage, error) {
 	p := path.Join(lookupVindexAPIPath(req.Organization, req.Database, req.Branch, req.Name), "internalize")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("LookupVindex", "Internalize"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -154,7 +154,7 @@ func (s *lookupVindexService) Internalize(ctx context.Context, req *LookupVindex
 
 func (s *lookupVindexService) Cancel(ctx context.Context, req *LookupVindexCancelRequest) (json.RawMessage, error) {
 	p := path.Join(lookupVindexAPIPath(req.Organization, req.Database, req.Branch, req.Name), "cancel")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("LookupVindex", "Cancel"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -167,7 +167,7 @@ func (s *lookupVindexService) Cancel(ctx context.Context, req *LookupVindexCance
 
 func (s *lookupVindexService) Complete(ctx context.Context, req *LookupVindexCompleteRequest) (json.RawMessage, error) {
 	p := path.Join(lookupVindexAPIPath(req.Organization, req.Database, req.Branch, req.Name), "complete")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("LookupVindex", "Complete"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -96,7 +96,7 @@ func materializeWorkflowAPIPath(org, db, branch, workflow string) string {
 
 func (s *materializeService) Create(ctx context.Context, req *MaterializeCreateRequest) (json.RawMessage, error) {
 	p := materializeWorkflowsAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Materialize", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -114,7 +114,7 @@ func (s *materializeService) Show(ctx context.Context, req *MaterializeShowReque
 	if req.IncludeLogs != nil {
 		v.Set("include_logs", strconv.FormatBool(*req.IncludeLogs))
 	}
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Materialize", "Show"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -127,7 +127,7 @@ func (s *materializeService) Show(ctx context.Context, req *MaterializeShowReque
 
 func (s *materializeService) Start(ctx context.Context, req *MaterializeStartRequest) (json.RawMessage, error) {
 	p := path.Join(materializeWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "start")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Materialize", "Start"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -140,7 +140,7 @@ func (s *materializeService) Start(ctx context.Context, req *MaterializeStartReq
 
 func (s *materializeService) Stop(ctx context.Context, req *MaterializeStopRequest) (json.RawMessage, error) {
 	p := path.Join(materializeWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "stop")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Materialize", "Stop"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -153,7 +153,7 @@ func (s *materializeService) Stop(ctx context.Context, req *MaterializeStopReque
 
 func (s *materializeService) Cancel(ctx context.Context, req *MaterializeCancelRequest) (json.RawMessage, error) {
 	p := path.Join(materializeWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "cancel")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("Materialize", "Cancel"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -129,14 +129,14 @@ func moveTablesWorkflowAPIPath(org, db, branch, workflow string) string {
 
 func (s *moveTablesService) Create(ctx context.Context, req *MoveTablesCreateRequest) (*VtctldOperationReference, error) {
 	p := moveTablesWorkflowsAPIPath(req.Organization, req.Database, req.Branch)
-	return s.enqueueOperation(ctx, p, req)
+	return s.enqueueOperation(ctx, "Create", p, req)
 }
 
 func (s *moveTablesService) Show(ctx context.Context, req *MoveTablesShowRequest) (json.RawMessage, error) {
 	p := moveTablesWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow)
 	v := url.Values{}
 	v.Set("target_keyspace", req.TargetKeyspace)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("MoveTables", "Show"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -151,7 +151,7 @@ func (s *moveTablesService) Status(ctx context.Context, req *MoveTablesStatusReq
 	p := path.Join(moveTablesWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "status")
 	v := url.Values{}
 	v.Set("target_keyspace", req.TargetKeyspace)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("MoveTables", "Status"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -164,26 +164,26 @@ func (s *moveTablesService) Status(ctx context.Context, req *MoveTablesStatusReq
 
 func (s *moveTablesService) SwitchTraffic(ctx context.Context, req *MoveTablesSwitchTrafficRequest) (*VtctldOperationReference, error) {
 	p := path.Join(moveTablesWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "switch-traffic")
-	return s.enqueueOperation(ctx, p, req)
+	return s.enqueueOperation(ctx, "SwitchTraffic", p, req)
 }
 
 func (s *moveTablesService) ReverseTraffic(ctx context.Context, req *MoveTablesReverseTrafficRequest) (*VtctldOperationReference, error) {
 	p := path.Join(moveTablesWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "reverse-traffic")
-	return s.enqueueOperation(ctx, p, req)
+	return s.enqueueOperation(ctx, "ReverseTraffic", p, req)
 }
 
 func (s *moveTablesService) Cancel(ctx context.Context, req *MoveTablesCancelRequest) (*VtctldOperationReference, error) {
 	p := path.Join(moveTablesWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "cancel")
-	return s.enqueueOperation(ctx, p, req)
+	return s.enqueueOperation(ctx, "Cancel", p, req)
 }
 
 func (s *moveTablesService) Complete(ctx context.Context, req *MoveTablesCompleteRequest) (*VtctldOperationReference, error) {
 	p := path.Join(moveTablesWorkflowAPIPath(req.Organization, req.Database, req.Branch, req.Workflow), "complete")
-	return s.enqueueOperation(ctx, p, req)
+	return s.enqueueOperation(ctx, "Complete", p, req)
 }
 
-func (s *moveTablesService) enqueueOperation(ctx context.Context, p string, payload interface{}) (*VtctldOperationReference, error) {
-	httpReq, err := s.client.newRequest(http.MethodPost, p, payload)
+func (s *moveTablesService) enqueueOperation(ctx context.Context, method, p string, payload interface{}) (*VtctldOperationReference, error) {
+	httpReq, err := s.client.newRequest(http.MethodPost, p, payload, withOperation("MoveTables", method))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -17,6 +17,34 @@ type GetVtctldOperationRequest struct {
 	ID           string `json:"-"`
 }
 
+// WaitVtctldOperationRequest is a request for waiting until a vtctld
+// operation completes.
+type WaitVtctldOperationRequest struct {
+	Organization string `json:"-"`
+	Database     string `json:"-"`
+	Branch       string `json:"-"`
+	ID           string `json:"-"`
+
+	// Result, if set, is a pointer the result of the operation is decoded
+	// into once it completes.
+	Result interface{} `json:"-"`
+
+	// Progress, if set, is called with the operation after every poll.
+	Progress func(*VtctldOperation) `json:"-"`
+}
+
+// VtctldOperationError is returned by WaitOperation when a vtctld operation
+// completes with an error.
+type VtctldOperationError struct {
+	ID      string
+	Action  string
+	Message string
+}
+
+func (e *VtctldOperationError) Error() string {
+	return fmt.Sprintf("vtctld operation %s (%s) failed: %s", e.ID, e.Action, e.Message)
+}
+
 // VtctldOperationReference identifies an accepted vtctld operation that can be
 // polled later.
 type VtctldOperationReference struct {
@@ -25,17 +53,56 @@ type VtctldOperationReference struct {
 
 // VtctldOperation represents a generic vtctld operation resource.
 type VtctldOperation struct {
-	ID          string          `json:"id"`
-	Type        string          `json:"type"`
-	Action      string          `json:"action"`
-	Timeout     int             `json:"timeout"`
-	CreatedAt   time.Time       `json:"created_at"`
-	CompletedAt *time.Time      `json:"completed_at"`
-	State       string          `json:"state"`
-	Completed   bool            `json:"completed"`
-	Metadata    json.RawMessage `json:"metadata"`
-	Result      json.RawMessage `json:"result"`
-	Error       string          `json:"error"`
+	ID          string               `json:"id"`
+	Type        string               `json:"type"`
+	Action      string               `json:"action"`
+	Timeout     int                  `json:"timeout"`
+	CreatedAt   time.Time            `json:"created_at"`
+	CompletedAt *time.Time           `json:"completed_at"`
+	State       VtctldOperationState `json:"state"`
+	Completed   bool                 `json:"completed"`
+	Metadata    json.RawMessage      `json:"metadata"`
+	Result      json.RawMessage      `json:"result"`
+	Error       string               `json:"error"`
+}
+
+// VtctldOperationState is the state of a vtctld operation.
+type VtctldOperationState string
+
+const (
+	VtctldOperationPending   VtctldOperationState = "pending"
+	VtctldOperationRunning   VtctldOperationState = "running"
+	VtctldOperationCompleted VtctldOperationState = "completed"
+	VtctldOperationFailed    VtctldOperationState = "failed"
+)
+
+var vtctldOperationStates = map[VtctldOperationState]stateClass{
+	VtctldOperationPending:   stateWaiting,
+	VtctldOperationRunning:   stateInProgress,
+	VtctldOperationCompleted: stateFinished,
+	VtctldOperationFailed:    stateFailed,
+}
+
+// IsKnown reports whether this package declares s.
+func (s VtctldOperationState) IsKnown() bool {
+	return vtctldOperationStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the operation finished, successfully or
+// not.
+func (s VtctldOperationState) IsTerminal() bool {
+	return vtctldOperationStates[s].terminal()
+}
+
+// IsFailed reports whether the operation failed. Error holds the
+// reason.
+func (s VtctldOperationState) IsFailed() bool {
+	return vtctldOperationStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the operation is running.
+func (s VtctldOperationState) IsInProgress() bool {
+	return vtctldOperationStates[s] == stateInProgress
 }
 
 func vtctldOperationsAPIPath(org, db, branch string) string {
@@ -48,7 +115,7 @@ func vtctldOperationAPIPath(org, db, branch, id string) string {
 
 func (s *vtctldService) GetOperation(ctx context.Context, req *GetVtctldOperationRequest) (*VtctldOperation, error) {
 	p := vtctldOperationAPIPath(req.Organization, req.Database, req.Branch, req.ID)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil)
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, withOperation("Vtctld", "GetOperation"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -60,3 +127,56 @@ func (s *vtctldService) GetOperation(ctx context.Context, req *GetVtctldOperatio
 
 	return resp, nil
 }
+
+// WaitOperation polls the vtctld operation until it completes, decodes its
+// result into waitReq.Result and returns it. An operation that completes
+// with an error fails with a *VtctldOperationError, and one that completes
+// without the result asked for fails too. If ctx is done first,
+// WaitOperation returns ctx's error along with the last operation seen.
+func (s *vtctldService) WaitOperation(ctx context.Context, waitReq *WaitVtctldOperationRequest, opts ...WaitOption) (*VtctldOperation, error) {
+	getReq := &GetVtctldOperationRequest{
+		Organization: waitReq.Organization,
+		Database:     waitReq.Database,
+		Branch:       waitReq.Branch,
+		ID:           waitReq.ID,
+	}
+
+	var op *VtctldOperation
+	err := poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		o, err := s.GetOperation(ctx, getReq)
+		if err != nil {
+			return false, err
+		}
+		op = o
+		if waitReq.Progress != nil {
+			waitReq.Progress(o)
+		}
+		// Completed may be set before the state settles, so it only
+		// counts for states this package doesn't know.
+		if o.State.IsKnown() {
+			return o.State.IsTerminal(), nil
+		}
+		return o.Completed, nil
+	})
+	if err != nil {
+		return op, err
+	}
+
+	if op.Error != "" || op.State.IsFailed() {
+		msg := op.Error
+		if msg == "" {
+			msg = "operation is in state " + string(op.State)
+		}
+		return op, &VtctldOperationError{ID: op.ID, Action: op.Action, Message: msg}
+	}
+
+	if waitReq.Result != nil {
+		if len(op.Result) == 0 || string(op.Result) == "null" {
+			return op, fmt.Errorf("vtctld operation %s completed without a result", op.ID)
+		}
+		if err := json.Unmarshal(op.Result, waitReq.Result); err != nil {
+			return op, fmt.Errorf("decoding vtctld operation result: %w", err)
+		}
+	}
+	return op, nil
+}
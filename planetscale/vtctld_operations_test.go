@@ -50,7 +50,7 @@ func TestVtctldOperations_Get(t *testing.T) {
 	c.Assert(err, qt.IsNil)
 	c.Assert(operation.ID, qt.Equals, "op-123")
 	c.Assert(operation.Action, qt.Equals, "move_tables_switch_traffic")
-	c.Assert(operation.State, qt.Equals, "completed")
+	c.Assert(operation.State, qt.Equals, VtctldOperationCompleted)
 	c.Assert(operation.Completed, qt.IsTrue)
 	c.Assert(operation.Timeout, qt.Equals, 300)
 	c.Assert(operation.CreatedAt, qt.Equals, createdAt)
@@ -62,3 +62,131 @@ func TestVtctldOperations_Get(t *testing.T) {
 	c.Assert(string(operation.Result), qt.Equals, `{"summary":"done"}`)
 	c.Assert(operation.Error, qt.Equals, "")
 }
+
+func TestVtctldOperations_Wait(t *testing.T) {
+	c := qt.New(t)
+
+	responses := []string{
+		`{"id":"op-123","action":"move_tables_create","state":"pending","completed":false}`,
+		`{"id":"op-123","action":"move_tables_create","state":"running","completed":false}`,
+		`{"id":"op-123","action":"move_tables_create","state":"completed","completed":true,"result":{"summary":"done","tables":2}}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.URL.Path, qt.Equals, "/v1/organizations/my-org/databases/my-db/branches/my-branch/vtctld/operations/op-123")
+		res := responses[0]
+		responses = responses[1:]
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(res))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var result struct {
+		Summary string `json:"summary"`
+		Tables  int    `json:"tables"`
+	}
+	var states []VtctldOperationState
+	op, err := client.Vtctld.WaitOperation(context.Background(), &WaitVtctldOperationRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "my-branch",
+		ID:           "op-123",
+		Result:       &result,
+		Progress: func(op *VtctldOperation) {
+			states = append(states, op.State)
+		},
+	}, WithPollInterval(time.Millisecond, time.Millisecond))
+	c.Assert(err, qt.IsNil)
+	c.Assert(op.Completed, qt.IsTrue)
+	c.Assert(result.Summary, qt.Equals, "done")
+	c.Assert(result.Tables, qt.Equals, 2)
+	c.Assert(states, qt.DeepEquals, []VtctldOperationState{VtctldOperationPending, VtctldOperationRunning, VtctldOperationCompleted})
+}
+
+func TestVtctldOperations_WaitError(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"op-123","action":"move_tables_switch_traffic","state":"failed","completed":true,"error":"cannot switch traffic: replication lag too high"}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	op, err := client.Vtctld.WaitOperation(context.Background(), &WaitVtctldOperationRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "my-branch",
+		ID:           "op-123",
+	})
+	c.Assert(op.ID, qt.Equals, "op-123")
+	var opErr *VtctldOperationError
+	c.Assert(err, qt.ErrorAs, &opErr)
+	c.Assert(opErr, qt.DeepEquals, &VtctldOperationError{
+		ID:      "op-123",
+		Action:  "move_tables_switch_traffic",
+		Message: "cannot switch traffic: replication lag too high",
+	})
+	c.Assert(err, qt.ErrorMatches, `vtctld operation op-123 \(move_tables_switch_traffic\) failed: cannot switch traffic: replication lag too high`)
+}
+
+func TestVtctldOperations_WaitSettledState(t *testing.T) {
+	c := qt.New(t)
+
+	responses := []string{
+		`{"id":"op-123","action":"move_tables_create","state":"running","completed":true}`,
+		`{"id":"op-123","action":"move_tables_create","state":"completed","completed":true,"result":{"summary":"done"}}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := responses[0]
+		responses = responses[1:]
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(res))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var result struct {
+		Summary string `json:"summary"`
+	}
+	op, err := client.Vtctld.WaitOperation(context.Background(), &WaitVtctldOperationRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "my-branch",
+		ID:           "op-123",
+		Result:       &result,
+	}, WithPollInterval(time.Millisecond, time.Millisecond))
+	c.Assert(err, qt.IsNil)
+	c.Assert(op.State, qt.Equals, VtctldOperationCompleted)
+	c.Assert(result.Summary, qt.Equals, "done")
+}
+
+func TestVtctldOperations_WaitMissingResult(t *testing.T) {
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"op-123","action":"move_tables_create","state":"completed","completed":true}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(WithBaseURL(ts.URL))
+	c.Assert(err, qt.IsNil)
+
+	var result struct{}
+	op, err := client.Vtctld.WaitOperation(context.Background(), &WaitVtctldOperationRequest{
+		Organization: "my-org",
+		Database:     "my-db",
+		Branch:       "my-branch",
+		ID:           "op-123",
+		Result:       &result,
+	})
+	c.Assert(err, qt.ErrorMatches, "vtctld operation op-123 completed without a result")
+	c.Assert(op.ID, qt.Equals, "op-123")
+}
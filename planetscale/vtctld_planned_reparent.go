@@ -46,7 +46,7 @@ func plannedReparentAPIPath(org, db, branch string) string {
 
 func (s *plannedReparentShardService) Create(ctx context.Context, req *PlannedReparentShardRequest) (*VtctldOperation, error) {
 	p := plannedReparentAPIPath(req.Organization, req.Database, req.Branch)
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("PlannedReparentShard", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -61,7 +61,7 @@ func (s *plannedReparentShardService) Create(ctx context.Context, req *PlannedRe
 
 func (s *plannedReparentShardService) Get(ctx context.Context, req *GetPlannedReparentShardRequest) (*VtctldOperation, error) {
 	p := path.Join(plannedReparentAPIPath(req.Organization, req.Database, req.Branch), req.ID)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil)
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, withOperation("PlannedReparentShard", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
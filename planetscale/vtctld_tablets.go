@@ -66,7 +66,7 @@ func (s *vtctldService) ListTablets(ctx context.Context, req *ListBranchTabletsR
 		v.Set("tablet_alias", strings.Join(req.TabletAliases, ","))
 	}
 
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("Vtctld", "ListTablets"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -105,7 +105,7 @@ func vdiffVDiffAPIPath(org, db, branch, workflow, uuid string) string {
 
 func (s *vdiffService) Create(ctx context.Context, req *VDiffCreateRequest) (json.RawMessage, error) {
 	p := vdiffVDiffsAPIPath(req.Organization, req.Database, req.Branch, req.Workflow)
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("VDiff", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -120,7 +120,7 @@ func (s *vdiffService) List(ctx context.Context, req *VDiffListRequest) (json.Ra
 	p := vdiffVDiffsAPIPath(req.Organization, req.Database, req.Branch, req.Workflow)
 	v := url.Values{}
 	v.Set("target_keyspace", req.TargetKeyspace)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("VDiff", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -135,7 +135,7 @@ func (s *vdiffService) Show(ctx context.Context, req *VDiffShowRequest) (json.Ra
 	p := vdiffVDiffAPIPath(req.Organization, req.Database, req.Branch, req.Workflow, req.UUID)
 	v := url.Values{}
 	v.Set("target_keyspace", req.TargetKeyspace)
-	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodGet, p, nil, WithQueryParams(v), withOperation("VDiff", "Show"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -148,7 +148,7 @@ func (s *vdiffService) Show(ctx context.Context, req *VDiffShowRequest) (json.Ra
 
 func (s *vdiffService) Stop(ctx context.Context, req *VDiffStopRequest) (json.RawMessage, error) {
 	p := path.Join(vdiffVDiffAPIPath(req.Organization, req.Database, req.Branch, req.Workflow, req.UUID), "stop")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("VDiff", "Stop"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -161,7 +161,7 @@ func (s *vdiffService) Stop(ctx context.Context, req *VDiffStopRequest) (json.Ra
 
 func (s *vdiffService) Resume(ctx context.Context, req *VDiffResumeRequest) (json.RawMessage, error) {
 	p := path.Join(vdiffVDiffAPIPath(req.Organization, req.Database, req.Branch, req.Workflow, req.UUID), "resume")
-	httpReq, err := s.client.newRequest(http.MethodPost, p, req)
+	httpReq, err := s.client.newRequest(http.MethodPost, p, req, withOperation("VDiff", "Resume"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -176,7 +176,7 @@ func (s *vdiffService) Delete(ctx context.Context, req *VDiffDeleteRequest) (jso
 	p := vdiffVDiffAPIPath(req.Organization, req.Database, req.Branch, req.Workflow, req.UUID)
 	v := url.Values{}
 	v.Set("target_keyspace", req.TargetKeyspace)
-	httpReq, err := s.client.newRequest(http.MethodDelete, p, nil, WithQueryParams(v))
+	httpReq, err := s.client.newRequest(http.MethodDelete, p, nil, WithQueryParams(v), withOperation("VDiff", "Delete"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
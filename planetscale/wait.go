@@ -0,0 +1,130 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	defaultPollMinInterval = time.Second
+	defaultPollMaxInterval = 15 * time.Second
+
+	// defaultErrorTolerance is how many polls in a row may fail with a
+	// transient error before a wait fails.
+	defaultErrorTolerance = 3
+)
+
+// WaitOption configures how the Wait methods of the services poll the API.
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	minInterval    time.Duration
+	maxInterval    time.Duration
+	errorTolerance int
+}
+
+// WithPollInterval sets the delay between two polls. It starts at min and
+// doubles after every poll, up to max. The defaults are one and 15 seconds.
+func WithPollInterval(min, max time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		if min > 0 {
+			o.minInterval = min
+		}
+		if max > 0 {
+			o.maxInterval = max
+		}
+		if o.maxInterval < o.minInterval {
+			o.maxInterval = o.minInterval
+		}
+	}
+}
+
+// WithErrorTolerance sets how many polls in a row may fail with a transient
+// error, such as a 5xx response or a 404 for a resource that was just
+// created, before the wait fails with that error. The default is 3; zero
+// fails on the first error.
+func WithErrorTolerance(n int) WaitOption {
+	return func(o *waitOptions) {
+		o.errorTolerance = max(n, 0)
+	}
+}
+
+// StateError is returned by the Wait methods when the resource waited for
+// reaches a state in which it won't become what was waited for, such as a
+// failed deployment.
+type StateError struct {
+	// Resource names the resource, e.g. "deploy request 7".
+	Resource string
+
+	// State is the state the resource reached.
+	State string
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("%s is in state %s", e.Resource, e.State)
+}
+
+// poll calls check until it reports done or fails, waiting between calls as
+// configured by opts. Transient errors are retried up to the configured
+// tolerance. It returns ctx's error if ctx is done first.
+func poll(ctx context.Context, opts []WaitOption, check func(ctx context.Context) (done bool, err error)) error {
+	o := waitOptions{
+		minInterval:    defaultPollMinInterval,
+		maxInterval:    defaultPollMaxInterval,
+		errorTolerance: defaultErrorTolerance,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	wait := o.minInterval
+	failures := 0
+	for {
+		done, err := check(ctx)
+		switch {
+		case err == nil:
+			if done {
+				return nil
+			}
+			failures = 0
+		case !transient(err):
+			return err
+		case ctx.Err() != nil:
+			// The request failed because ctx is done.
+			return ctx.Err()
+		case failures < o.errorTolerance:
+			failures++
+		default:
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		wait = min(wait*2, o.maxInterval)
+	}
+}
+
+// transient reports whether err may go away by itself: the API is
+// unavailable or busy, the resource isn't visible yet, or the network failed.
+func transient(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		for _, code := range []ErrorCode{ErrNotFound, ErrUnavailable, ErrRetry, ErrRateLimited} {
+			if apiErr.Is(&Error{Code: code}) {
+				return true
+			}
+		}
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
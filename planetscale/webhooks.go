@@ -2,6 +2,7 @@ package planetscale
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 	"time"
@@ -13,6 +14,7 @@ var _ WebhooksService = &webhooksService{}
 // Webhooks API.
 type WebhooksService interface {
 	List(context.Context, *ListWebhooksRequest, ...ListOption) ([]*Webhook, error)
+	All(context.Context, *ListWebhooksRequest, ...ListOption) iter.Seq2[*Webhook, error]
 	Create(context.Context, *CreateWebhookRequest) (*Webhook, error)
 	Get(context.Context, *GetWebhookRequest) (*Webhook, error)
 	Update(context.Context, *UpdateWebhookRequest) (*Webhook, error)
@@ -21,6 +23,7 @@ type WebhooksService interface {
 }
 
 type webhooksResponse struct {
+	Pagination
 	Webhooks []*Webhook `json:"data"`
 }
 
@@ -51,6 +54,10 @@ type CreateWebhookRequest struct {
 	URL          string   `json:"url"`
 	Enabled      *bool    `json:"enabled,omitempty"`
 	Events       []string `json:"events,omitempty"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // GetWebhookRequest is the request for getting a webhook.
@@ -91,7 +98,7 @@ type webhooksService struct {
 func (w *webhooksService) List(ctx context.Context, listReq *ListWebhooksRequest, opts ...ListOption) ([]*Webhook, error) {
 	listOpts := defaultListOptions(opts...)
 
-	req, err := w.client.newRequest(http.MethodGet, webhooksAPIPath(listReq.Organization, listReq.Database), nil, WithQueryParams(*listOpts.URLValues))
+	req, err := w.client.newRequest(http.MethodGet, webhooksAPIPath(listReq.Organization, listReq.Database), nil, WithQueryParams(*listOpts.URLValues), withOperation("Webhooks", "List"))
 	if err != nil {
 		return nil, err
 	}
@@ -100,12 +107,21 @@ func (w *webhooksService) List(ctx context.Context, listReq *ListWebhooksRequest
 	if err := w.client.do(ctx, req, &resp); err != nil {
 		return nil, err
 	}
+	listOpts.setPagination(resp.Pagination)
 
 	return resp.Webhooks, nil
 }
 
+// All returns an iterator over all webhooks of a database, fetching pages as
+// needed.
+func (w *webhooksService) All(ctx context.Context, listReq *ListWebhooksRequest, opts ...ListOption) iter.Seq2[*Webhook, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ...ListOption) ([]*Webhook, error) {
+		return w.List(ctx, listReq, opts...)
+	})
+}
+
 func (w *webhooksService) Create(ctx context.Context, createReq *CreateWebhookRequest) (*Webhook, error) {
-	req, err := w.client.newRequest(http.MethodPost, webhooksAPIPath(createReq.Organization, createReq.Database), createReq)
+	req, err := w.client.newRequest(http.MethodPost, webhooksAPIPath(createReq.Organization, createReq.Database), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("Webhooks", "Create"))
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +135,7 @@ func (w *webhooksService) Create(ctx context.Context, createReq *CreateWebhookRe
 }
 
 func (w *webhooksService) Get(ctx context.Context, getReq *GetWebhookRequest) (*Webhook, error) {
-	req, err := w.client.newRequest(http.MethodGet, webhookAPIPath(getReq.Organization, getReq.Database, getReq.ID), nil)
+	req, err := w.client.newRequest(http.MethodGet, webhookAPIPath(getReq.Organization, getReq.Database, getReq.ID), nil, withOperation("Webhooks", "Get"))
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +149,7 @@ func (w *webhooksService) Get(ctx context.Context, getReq *GetWebhookRequest) (*
 }
 
 func (w *webhooksService) Update(ctx context.Context, updateReq *UpdateWebhookRequest) (*Webhook, error) {
-	req, err := w.client.newRequest(http.MethodPatch, webhookAPIPath(updateReq.Organization, updateReq.Database, updateReq.ID), updateReq)
+	req, err := w.client.newRequest(http.MethodPatch, webhookAPIPath(updateReq.Organization, updateReq.Database, updateReq.ID), updateReq, withOperation("Webhooks", "Update"))
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +163,7 @@ func (w *webhooksService) Update(ctx context.Context, updateReq *UpdateWebhookRe
 }
 
 func (w *webhooksService) Delete(ctx context.Context, deleteReq *DeleteWebhookRequest) error {
-	req, err := w.client.newRequest(http.MethodDelete, webhookAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.ID), nil)
+	req, err := w.client.newRequest(http.MethodDelete, webhookAPIPath(deleteReq.Organization, deleteReq.Database, deleteReq.ID), nil, withOperation("Webhooks", "Delete"))
 	if err != nil {
 		return err
 	}
@@ -156,7 +172,7 @@ func (w *webhooksService) Delete(ctx context.Context, deleteReq *DeleteWebhookRe
 }
 
 func (w *webhooksService) Test(ctx context.Context, testReq *TestWebhookRequest) error {
-	req, err := w.client.newRequest(http.MethodPost, webhookTestAPIPath(testReq.Organization, testReq.Database, testReq.ID), nil)
+	req, err := w.client.newRequest(http.MethodPost, webhookTestAPIPath(testReq.Organization, testReq.Database, testReq.ID), nil, withOperation("Webhooks", "Test"))
 	if err != nil {
 		return err
 	}
@@ -9,24 +9,24 @@ import (
 )
 
 type Workflow struct {
-	ID                  string     `json:"id"`
-	Name                string     `json:"name"`
-	Number              uint64     `json:"number"`
-	State               string     `json:"state"`
-	CreatedAt           time.Time  `json:"created_at"`
-	UpdatedAt           time.Time  `json:"updated_at"`
-	StartedAt           *time.Time `json:"started_at"`
-	CompletedAt         *time.Time `json:"completed_at"`
-	CancelledAt         *time.Time `json:"cancelled_at"`
-	ReversedAt          *time.Time `json:"reversed_at"`
-	RetriedAt           *time.Time `json:"retried_at"`
-	DataCopyCompletedAt *time.Time `json:"data_copy_completed_at"`
-	CutoverAt           *time.Time `json:"cutover_at"`
-	ReplicasSwitched    bool       `json:"replicas_switched"`
-	PrimariesSwitched   bool       `json:"primaries_switched"`
-	SwitchReplicasAt    *time.Time `json:"switch_replicas_at"`
-	SwitchPrimariesAt   *time.Time `json:"switch_primaries_at"`
-	VerifyDataAt        *time.Time `json:"verify_data_at"`
+	ID                  string        `json:"id"`
+	Name                string        `json:"name"`
+	Number              uint64        `json:"number"`
+	State               WorkflowState `json:"state"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	StartedAt           *time.Time    `json:"started_at"`
+	CompletedAt         *time.Time    `json:"completed_at"`
+	CancelledAt         *time.Time    `json:"cancelled_at"`
+	ReversedAt          *time.Time    `json:"reversed_at"`
+	RetriedAt           *time.Time    `json:"retried_at"`
+	DataCopyCompletedAt *time.Time    `json:"data_copy_completed_at"`
+	CutoverAt           *time.Time    `json:"cutover_at"`
+	ReplicasSwitched    bool          `json:"replicas_switched"`
+	PrimariesSwitched   bool          `json:"primaries_switched"`
+	SwitchReplicasAt    *time.Time    `json:"switch_replicas_at"`
+	SwitchPrimariesAt   *time.Time    `json:"switch_primaries_at"`
+	VerifyDataAt        *time.Time    `json:"verify_data_at"`
 
 	Branch         DatabaseBranch `json:"branch"`
 	SourceKeyspace Keyspace       `json:"source_keyspace"`
@@ -48,6 +48,76 @@ type Workflow struct {
 	VDiff   *WorkflowVDiff    `json:"vdiff"`
 }
 
+// WorkflowState is the state of a workflow.
+type WorkflowState string
+
+const (
+	WorkflowPending                   WorkflowState = "pending"
+	WorkflowCopying                   WorkflowState = "copying"
+	WorkflowRunning                   WorkflowState = "running"
+	WorkflowStopped                   WorkflowState = "stopped"
+	WorkflowVerifyingData             WorkflowState = "verifying_data"
+	WorkflowVerifiedData              WorkflowState = "verified_data"
+	WorkflowSwitchingReplicas         WorkflowState = "switching_replicas"
+	WorkflowSwitchedReplicas          WorkflowState = "switched_replicas"
+	WorkflowSwitchingPrimaries        WorkflowState = "switching_primaries"
+	WorkflowSwitchedPrimaries         WorkflowState = "switched_primaries"
+	WorkflowReversingTraffic          WorkflowState = "reversing_traffic"
+	WorkflowReversingTrafficForCancel WorkflowState = "reversing_traffic_for_cancel"
+	WorkflowCuttingOver               WorkflowState = "cutting_over"
+	WorkflowCutover                   WorkflowState = "cutover"
+	WorkflowReversedCutover           WorkflowState = "reversed_cutover"
+	WorkflowCompleted                 WorkflowState = "completed"
+	WorkflowCancelling                WorkflowState = "cancelling"
+	WorkflowCancelled                 WorkflowState = "cancelled"
+	WorkflowError                     WorkflowState = "error"
+)
+
+var workflowStates = map[WorkflowState]stateClass{
+	WorkflowPending:                   stateWaiting,
+	WorkflowCopying:                   stateInProgress,
+	WorkflowRunning:                   stateInProgress,
+	WorkflowStopped:                   stateWaiting,
+	WorkflowVerifyingData:             stateInProgress,
+	WorkflowVerifiedData:              stateWaiting,
+	WorkflowSwitchingReplicas:         stateInProgress,
+	WorkflowSwitchedReplicas:          stateWaiting,
+	WorkflowSwitchingPrimaries:        stateInProgress,
+	WorkflowSwitchedPrimaries:         stateWaiting,
+	WorkflowReversingTraffic:          stateInProgress,
+	WorkflowReversingTrafficForCancel: stateInProgress,
+	WorkflowCuttingOver:               stateInProgress,
+	WorkflowCutover:                   stateWaiting,
+	WorkflowReversedCutover:           stateWaiting,
+	WorkflowCompleted:                 stateFinished,
+	WorkflowCancelling:                stateInProgress,
+	WorkflowCancelled:                 stateFinished,
+	WorkflowError:                     stateFailed,
+}
+
+// IsKnown reports whether this package declares s.
+func (s WorkflowState) IsKnown() bool {
+	return workflowStates[s] != stateUnknown
+}
+
+// IsTerminal reports whether the workflow completed, was cancelled or
+// failed.
+func (s WorkflowState) IsTerminal() bool {
+	return workflowStates[s].terminal()
+}
+
+// IsFailed reports whether the workflow failed.
+func (s WorkflowState) IsFailed() bool {
+	return workflowStates[s] == stateFailed
+}
+
+// IsInProgress reports whether the workflow is copying data, verifying
+// it or switching traffic. It is false in the states that wait for the next
+// step to be started, such as WorkflowSwitchedReplicas.
+func (s WorkflowState) IsInProgress() bool {
+	return workflowStates[s] == stateInProgress
+}
+
 type WorkflowStream struct {
 	PublicID             string              `json:"id"`
 	State                string              `json:"state"`
@@ -190,6 +260,10 @@ type CreateWorkflowRequest struct {
 	GlobalKeyspace     *string  `json:"global_keyspace"`
 	DeferSecondaryKeys *bool    `json:"defer_secondary_keys"`
 	OnDDL              *string  `json:"on_ddl"`
+
+	// IdempotencyKey, if set, is sent as the Idempotency-Key header, which
+	// lets a RetryPolicy retry the call. Without it, the call is sent once.
+	IdempotencyKey string `json:"-"`
 }
 
 // WorkflowsService is an interface for interacting with the workflow endpoints of the PlanetScale API
@@ -223,7 +297,7 @@ type workflowsResponse struct {
 }
 
 func (ws *workflowsService) List(ctx context.Context, listReq *ListWorkflowsRequest) ([]*Workflow, error) {
-	req, err := ws.client.newRequest(http.MethodGet, workflowsAPIPath(listReq.Organization, listReq.Database), nil)
+	req, err := ws.client.newRequest(http.MethodGet, workflowsAPIPath(listReq.Organization, listReq.Database), nil, withOperation("Workflows", "List"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -238,7 +312,7 @@ func (ws *workflowsService) List(ctx context.Context, listReq *ListWorkflowsRequ
 }
 
 func (ws *workflowsService) Get(ctx context.Context, getReq *GetWorkflowRequest) (*Workflow, error) {
-	req, err := ws.client.newRequest(http.MethodGet, workflowAPIPath(getReq.Organization, getReq.Database, getReq.WorkflowNumber), nil)
+	req, err := ws.client.newRequest(http.MethodGet, workflowAPIPath(getReq.Organization, getReq.Database, getReq.WorkflowNumber), nil, withOperation("Workflows", "Get"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -253,7 +327,7 @@ func (ws *workflowsService) Get(ctx context.Context, getReq *GetWorkflowRequest)
 }
 
 func (ws *workflowsService) Create(ctx context.Context, createReq *CreateWorkflowRequest) (*Workflow, error) {
-	req, err := ws.client.newRequest(http.MethodPost, workflowsAPIPath(createReq.Organization, createReq.Database), createReq)
+	req, err := ws.client.newRequest(http.MethodPost, workflowsAPIPath(createReq.Organization, createReq.Database), createReq, withIdempotencyKey(createReq.IdempotencyKey), withOperation("Workflows", "Create"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -269,7 +343,7 @@ func (ws *workflowsService) Create(ctx context.Context, createReq *CreateWorkflo
 
 func (ws *workflowsService) VerifyData(ctx context.Context, verifyDataReq *VerifyDataWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(verifyDataReq.Organization, verifyDataReq.Database, verifyDataReq.WorkflowNumber), "verify-data")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "VerifyData"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -285,7 +359,7 @@ func (ws *workflowsService) VerifyData(ctx context.Context, verifyDataReq *Verif
 
 func (ws *workflowsService) SwitchReplicas(ctx context.Context, switchReplicasReq *SwitchReplicasWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(switchReplicasReq.Organization, switchReplicasReq.Database, switchReplicasReq.WorkflowNumber), "switch-replicas")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "SwitchReplicas"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -301,7 +375,7 @@ func (ws *workflowsService) SwitchReplicas(ctx context.Context, switchReplicasRe
 
 func (ws *workflowsService) SwitchPrimaries(ctx context.Context, switchPrimariesReq *SwitchPrimariesWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(switchPrimariesReq.Organization, switchPrimariesReq.Database, switchPrimariesReq.WorkflowNumber), "switch-primaries")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "SwitchPrimaries"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -317,7 +391,7 @@ func (ws *workflowsService) SwitchPrimaries(ctx context.Context, switchPrimaries
 
 func (ws *workflowsService) ReverseTraffic(ctx context.Context, reverseTrafficReq *ReverseTrafficWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(reverseTrafficReq.Organization, reverseTrafficReq.Database, reverseTrafficReq.WorkflowNumber), "reverse-traffic")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "ReverseTraffic"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -333,7 +407,7 @@ func (ws *workflowsService) ReverseTraffic(ctx context.Context, reverseTrafficRe
 
 func (ws *workflowsService) Cutover(ctx context.Context, cutoverReq *CutoverWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(cutoverReq.Organization, cutoverReq.Database, cutoverReq.WorkflowNumber), "cutover")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "Cutover"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -349,7 +423,7 @@ func (ws *workflowsService) Cutover(ctx context.Context, cutoverReq *CutoverWork
 
 func (ws *workflowsService) ReverseCutover(ctx context.Context, reverseCutoverReq *ReverseCutoverWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(reverseCutoverReq.Organization, reverseCutoverReq.Database, reverseCutoverReq.WorkflowNumber), "reverse-cutover")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "ReverseCutover"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -365,7 +439,7 @@ func (ws *workflowsService) ReverseCutover(ctx context.Context, reverseCutoverRe
 
 func (ws *workflowsService) Complete(ctx context.Context, completeReq *CompleteWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(completeReq.Organization, completeReq.Database, completeReq.WorkflowNumber), "complete")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "Complete"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -381,7 +455,7 @@ func (ws *workflowsService) Complete(ctx context.Context, completeReq *CompleteW
 
 func (ws *workflowsService) Retry(ctx context.Context, retryReq *RetryWorkflowRequest) (*Workflow, error) {
 	pathStr := path.Join(workflowAPIPath(retryReq.Organization, retryReq.Database, retryReq.WorkflowNumber), "retry")
-	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil)
+	req, err := ws.client.newRequest(http.MethodPatch, pathStr, nil, withOperation("Workflows", "Retry"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
@@ -397,7 +471,7 @@ func (ws *workflowsService) Retry(ctx context.Context, retryReq *RetryWorkflowRe
 
 func (ws *workflowsService) Cancel(ctx context.Context, cancelReq *CancelWorkflowRequest) (*Workflow, error) {
 	path := workflowAPIPath(cancelReq.Organization, cancelReq.Database, cancelReq.WorkflowNumber)
-	req, err := ws.client.newRequest(http.MethodDelete, path, nil)
+	req, err := ws.client.newRequest(http.MethodDelete, path, nil, withOperation("Workflows", "Cancel"))
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
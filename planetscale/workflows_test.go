@@ -242,7 +242,7 @@ func TestWorkflows_SwitchReplicas(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "switched_replicas")
+	c.Assert(workflow.State, qt.Equals, WorkflowSwitchedReplicas)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -279,7 +279,7 @@ func TestWorkflows_SwitchPrimaries(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "switched_primaries")
+	c.Assert(workflow.State, qt.Equals, WorkflowSwitchedPrimaries)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -316,7 +316,7 @@ func TestWorkflows_ReverseTraffic(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "running")
+	c.Assert(workflow.State, qt.Equals, WorkflowRunning)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -353,7 +353,7 @@ func TestWorkflows_Cutover(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "cutover")
+	c.Assert(workflow.State, qt.Equals, WorkflowCutover)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -390,7 +390,7 @@ func TestWorkflows_ReverseCutover(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "reversed_cutover")
+	c.Assert(workflow.State, qt.Equals, WorkflowReversedCutover)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -427,7 +427,7 @@ func TestWorkflows_Complete(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "complete")
+	c.Assert(workflow.State, qt.Equals, WorkflowState("complete"))
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -464,7 +464,7 @@ func TestWorkflows_Retry(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "running")
+	c.Assert(workflow.State, qt.Equals, WorkflowRunning)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")
@@ -501,7 +501,7 @@ func TestWorkflows_Cancel(t *testing.T) {
 	c.Assert(workflow.ID, qt.Equals, wantID)
 	c.Assert(workflow.Name, qt.Equals, "shard-table")
 	c.Assert(workflow.Number, qt.Equals, uint64(1))
-	c.Assert(workflow.State, qt.Equals, "cancelled")
+	c.Assert(workflow.State, qt.Equals, WorkflowCancelled)
 	c.Assert(workflow.SourceKeyspace.Name, qt.Equals, "source-keyspace")
 	c.Assert(workflow.TargetKeyspace.Name, qt.Equals, "target-keyspace")
 	c.Assert(workflow.Branch.Name, qt.Equals, "branch")